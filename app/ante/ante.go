@@ -0,0 +1,78 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	ibcante "github.com/cosmos/ibc-go/v7/modules/core/ante"
+	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
+
+	circuitante "github.com/fatal-fruit/proton/x/circuit/ante"
+	circuittypes "github.com/fatal-fruit/proton/x/circuit/types"
+	feemarketante "github.com/fatal-fruit/proton/x/feemarket/ante"
+	globalfeeante "github.com/fatal-fruit/proton/x/globalfee/ante"
+)
+
+// HandlerOptions extend the SDK's AnteHandler options by requiring the IBC keeper.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	IBCKeeper       *ibckeeper.Keeper
+	GlobalFeeKeeper globalfeeante.GlobalFeeKeeper
+	FeeMarketKeeper feemarketante.FeeMarketKeeper
+	CircuitKeeper   circuitante.CircuitKeeper
+	GroupKeeper     circuittypes.GroupKeeper
+}
+
+// NewAnteHandler returns an AnteHandler that checks and increments sequence
+// numbers, checks signatures & account numbers, deducts fees from the first
+// signer, rejects redundant IBC relays and applies the proton specific checks.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+	if options.BankKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+	if options.SignModeHandler == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+	if options.IBCKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "ibc keeper is required for ante builder")
+	}
+	if options.GlobalFeeKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "globalfee keeper is required for ante builder")
+	}
+	if options.FeeMarketKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "feemarket keeper is required for ante builder")
+	}
+	if options.CircuitKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "circuit keeper is required for ante builder")
+	}
+	if options.GroupKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "group keeper is required for ante builder")
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper, options.GroupKeeper),
+		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		NewMinCommissionDecorator(),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		globalfeeante.NewGlobalFeeDecorator(options.GlobalFeeKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		feemarketante.NewFeeMarketDecorator(options.FeeMarketKeeper, options.GlobalFeeKeeper), // burns the base fee collected by DeductFeeDecorator
+		ante.NewSetPubKeyDecorator(options.AccountKeeper),                                     // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
@@ -0,0 +1,291 @@
+package ante_test
+
+import (
+	"math/rand"
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/app/ante"
+	circuittypes "github.com/fatal-fruit/proton/x/circuit/types"
+	feemarkettypes "github.com/fatal-fruit/proton/x/feemarket/types"
+	globalfeetypes "github.com/fatal-fruit/proton/x/globalfee/types"
+)
+
+const chainID = "proton-test"
+
+type account struct {
+	priv cryptotypes.PrivKey
+	addr sdk.AccAddress
+}
+
+func setup(t *testing.T) (*app.ProtonApp, sdk.Context, sdk.AnteHandler) {
+	t.Helper()
+
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{ChainID: chainID, Height: 1})
+
+	require.NoError(t, protonApp.AccountKeeper.SetParams(ctx, authtypes.DefaultParams()))
+	require.NoError(t, protonApp.BankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+
+	anteHandler, err := ante.NewAnteHandler(ante.HandlerOptions{
+		HandlerOptions: sdkante.HandlerOptions{
+			AccountKeeper:   protonApp.AccountKeeper,
+			BankKeeper:      protonApp.BankKeeper,
+			FeegrantKeeper:  protonApp.FeeGrantKeeper,
+			SignModeHandler: protonApp.TxConfig().SignModeHandler(),
+			SigGasConsumer:  sdkante.DefaultSigVerificationGasConsumer,
+		},
+		IBCKeeper:       protonApp.IBCKeeper,
+		GlobalFeeKeeper: protonApp.GlobalFeeKeeper,
+		FeeMarketKeeper: protonApp.FeeMarketKeeper,
+		CircuitKeeper:   protonApp.CircuitKeeper,
+		GroupKeeper:     protonApp.GroupKeeper,
+	})
+	require.NoError(t, err)
+
+	return protonApp, ctx, anteHandler
+}
+
+func newAccount(t *testing.T, protonApp *app.ProtonApp, ctx sdk.Context, balance sdk.Coins) account {
+	t.Helper()
+
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	protonApp.AccountKeeper.SetAccount(ctx, protonApp.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	if !balance.IsZero() {
+		require.NoError(t, banktestutil.FundAccount(protonApp.BankKeeper, ctx, addr, balance))
+	}
+
+	return account{priv: priv, addr: addr}
+}
+
+func signTx(t *testing.T, protonApp *app.ProtonApp, ctx sdk.Context, acc account, msgs []sdk.Msg, fees sdk.Coins) sdk.Tx {
+	t.Helper()
+
+	authAcc := protonApp.AccountKeeper.GetAccount(ctx, acc.addr)
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(1)),
+		protonApp.TxConfig(),
+		msgs,
+		fees,
+		simtestutil.DefaultGenTxGas,
+		chainID,
+		[]uint64{authAcc.GetAccountNumber()},
+		[]uint64{authAcc.GetSequence()},
+		acc.priv,
+	)
+	require.NoError(t, err)
+
+	return tx
+}
+
+func TestAnteHandler(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1_000))
+	funds := sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1_000_000))
+
+	testCases := []struct {
+		name     string
+		malleate func(protonApp *app.ProtonApp, ctx sdk.Context, handler sdk.AnteHandler) sdk.Tx
+		expErr   error
+	}{
+		{
+			"signed and funded tx is accepted",
+			func(protonApp *app.ProtonApp, ctx sdk.Context, _ sdk.AnteHandler) sdk.Tx {
+				from := newAccount(t, protonApp, ctx, funds)
+				to := newAccount(t, protonApp, ctx, nil)
+				msg := banktypes.NewMsgSend(from.addr, to.addr, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+				return signTx(t, protonApp, ctx, from, []sdk.Msg{msg}, fees)
+			},
+			nil,
+		},
+		{
+			"unsigned tx is rejected",
+			func(protonApp *app.ProtonApp, ctx sdk.Context, _ sdk.AnteHandler) sdk.Tx {
+				from := newAccount(t, protonApp, ctx, funds)
+				to := newAccount(t, protonApp, ctx, nil)
+				msg := banktypes.NewMsgSend(from.addr, to.addr, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+
+				txBuilder := protonApp.TxConfig().NewTxBuilder()
+				require.NoError(t, txBuilder.SetMsgs(msg))
+				txBuilder.SetFeeAmount(fees)
+				txBuilder.SetGasLimit(simtestutil.DefaultGenTxGas)
+				return txBuilder.GetTx()
+			},
+			sdkerrors.ErrNoSignatures,
+		},
+		{
+			"tx signed by the wrong key is rejected",
+			func(protonApp *app.ProtonApp, ctx sdk.Context, _ sdk.AnteHandler) sdk.Tx {
+				from := newAccount(t, protonApp, ctx, funds)
+				to := newAccount(t, protonApp, ctx, nil)
+				msg := banktypes.NewMsgSend(from.addr, to.addr, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+				return signTx(t, protonApp, ctx, account{priv: to.priv, addr: from.addr}, []sdk.Msg{msg}, fees)
+			},
+			sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			"underfunded tx is rejected",
+			func(protonApp *app.ProtonApp, ctx sdk.Context, _ sdk.AnteHandler) sdk.Tx {
+				from := newAccount(t, protonApp, ctx, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+				to := newAccount(t, protonApp, ctx, nil)
+				msg := banktypes.NewMsgSend(from.addr, to.addr, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+				return signTx(t, protonApp, ctx, from, []sdk.Msg{msg}, fees)
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			"replayed tx is rejected",
+			func(protonApp *app.ProtonApp, ctx sdk.Context, handler sdk.AnteHandler) sdk.Tx {
+				from := newAccount(t, protonApp, ctx, funds)
+				to := newAccount(t, protonApp, ctx, nil)
+				msg := banktypes.NewMsgSend(from.addr, to.addr, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+				tx := signTx(t, protonApp, ctx, from, []sdk.Msg{msg}, fees)
+
+				_, err := handler(ctx, tx, false)
+				require.NoError(t, err)
+				return tx
+			},
+			sdkerrors.ErrWrongSequence,
+		},
+		{
+			"fee below the global minimum gas price is rejected",
+			func(protonApp *app.ProtonApp, ctx sdk.Context, _ sdk.AnteHandler) sdk.Tx {
+				params := globalfeetypes.DefaultParams()
+				params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(app.BondDenom, sdk.NewDecWithPrec(1, 1)))
+				require.NoError(t, protonApp.GlobalFeeKeeper.SetParams(ctx, params))
+
+				from := newAccount(t, protonApp, ctx, funds)
+				to := newAccount(t, protonApp, ctx, nil)
+				msg := banktypes.NewMsgSend(from.addr, to.addr, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+				return signTx(t, protonApp, ctx, from, []sdk.Msg{msg}, fees)
+			},
+			globalfeetypes.ErrInsufficientFee,
+		},
+		{
+			"fee below the base fee is rejected",
+			func(protonApp *app.ProtonApp, ctx sdk.Context, _ sdk.AnteHandler) sdk.Tx {
+				params := feemarkettypes.DefaultParams()
+				params.FeeDenom = app.BondDenom
+				require.NoError(t, protonApp.FeeMarketKeeper.SetParams(ctx, params))
+				protonApp.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(1, 3))
+
+				from := newAccount(t, protonApp, ctx, funds)
+				to := newAccount(t, protonApp, ctx, nil)
+				msg := banktypes.NewMsgSend(from.addr, to.addr, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+				return signTx(t, protonApp, ctx, from, []sdk.Msg{msg}, fees)
+			},
+			feemarkettypes.ErrInsufficientFee,
+		},
+		{
+			"zero-fee bypass tx is exempt from a positive base fee",
+			func(protonApp *app.ProtonApp, ctx sdk.Context, _ sdk.AnteHandler) sdk.Tx {
+				feeMarketParams := feemarkettypes.DefaultParams()
+				feeMarketParams.FeeDenom = app.BondDenom
+				feeMarketParams.MinBaseFee = sdk.NewDecWithPrec(1, 3)
+				require.NoError(t, protonApp.FeeMarketKeeper.SetParams(ctx, feeMarketParams))
+				protonApp.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(1, 3))
+
+				bypassMsgTypes := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+				require.NoError(t, protonApp.GlobalFeeKeeper.SetParams(ctx, globalfeetypes.NewParams(sdk.DecCoins{}, bypassMsgTypes, simtestutil.DefaultGenTxGas)))
+
+				from := newAccount(t, protonApp, ctx, funds)
+				to := newAccount(t, protonApp, ctx, nil)
+				msg := banktypes.NewMsgSend(from.addr, to.addr, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+				return signTx(t, protonApp, ctx, from, []sdk.Msg{msg}, sdk.Coins{})
+			},
+			nil,
+		},
+		{
+			"zero-fee bypass tx over the bypass gas limit pays the base fee",
+			func(protonApp *app.ProtonApp, ctx sdk.Context, _ sdk.AnteHandler) sdk.Tx {
+				feeMarketParams := feemarkettypes.DefaultParams()
+				feeMarketParams.FeeDenom = app.BondDenom
+				feeMarketParams.MinBaseFee = sdk.NewDecWithPrec(1, 3)
+				require.NoError(t, protonApp.FeeMarketKeeper.SetParams(ctx, feeMarketParams))
+				protonApp.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(1, 3))
+
+				bypassMsgTypes := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+				require.NoError(t, protonApp.GlobalFeeKeeper.SetParams(ctx, globalfeetypes.NewParams(sdk.DecCoins{}, bypassMsgTypes, simtestutil.DefaultGenTxGas-1)))
+
+				from := newAccount(t, protonApp, ctx, funds)
+				to := newAccount(t, protonApp, ctx, nil)
+				msg := banktypes.NewMsgSend(from.addr, to.addr, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+				return signTx(t, protonApp, ctx, from, []sdk.Msg{msg}, sdk.Coins{})
+			},
+			feemarkettypes.ErrInsufficientFee,
+		},
+		{
+			"validator commission below the minimum is rejected",
+			func(protonApp *app.ProtonApp, ctx sdk.Context, _ sdk.AnteHandler) sdk.Tx {
+				from := newAccount(t, protonApp, ctx, funds)
+				msg, err := stakingtypes.NewMsgCreateValidator(
+					sdk.ValAddress(from.addr),
+					ed25519.GenPrivKey().PubKey(),
+					sdk.NewInt64Coin(app.BondDenom, 100),
+					stakingtypes.NewDescription("validator", "", "", "", ""),
+					stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 2), sdk.OneDec(), sdk.NewDecWithPrec(1, 2)),
+					sdk.OneInt(),
+				)
+				require.NoError(t, err)
+				return signTx(t, protonApp, ctx, from, []sdk.Msg{msg}, fees)
+			},
+			ante.ErrCommissionTooLow,
+		},
+		{
+			"msg with a tripped circuit is rejected",
+			func(protonApp *app.ProtonApp, ctx sdk.Context, _ sdk.AnteHandler) sdk.Tx {
+				protonApp.CircuitKeeper.DisableMsg(ctx, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+
+				from := newAccount(t, protonApp, ctx, funds)
+				to := newAccount(t, protonApp, ctx, nil)
+				msg := banktypes.NewMsgSend(from.addr, to.addr, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+				return signTx(t, protonApp, ctx, from, []sdk.Msg{msg}, fees)
+			},
+			circuittypes.ErrCircuitBreakerTripped,
+		},
+		{
+			"msg with a tripped circuit nested in authz exec is rejected",
+			func(protonApp *app.ProtonApp, ctx sdk.Context, _ sdk.AnteHandler) sdk.Tx {
+				protonApp.CircuitKeeper.DisableMsg(ctx, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+
+				grantee := newAccount(t, protonApp, ctx, funds)
+				granter := newAccount(t, protonApp, ctx, funds)
+				send := banktypes.NewMsgSend(granter.addr, grantee.addr, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+				msg := authz.NewMsgExec(grantee.addr, []sdk.Msg{send})
+				return signTx(t, protonApp, ctx, grantee, []sdk.Msg{&msg}, fees)
+			},
+			circuittypes.ErrCircuitBreakerTripped,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			protonApp, ctx, anteHandler := setup(t)
+			tx := tc.malleate(protonApp, ctx, anteHandler)
+
+			_, err := anteHandler(ctx, tx, false)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
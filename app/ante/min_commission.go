@@ -0,0 +1,59 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MinCommissionRate is the lowest commission rate a proton validator may set.
+var MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+
+// ErrCommissionTooLow is returned for a commission rate below
+// MinCommissionRate. It keeps the ABCI code of ErrInvalidRequest.
+var ErrCommissionTooLow = errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "commission rate below the minimum")
+
+// MinCommissionDecorator rejects validator creations and edits which set a
+// commission rate below MinCommissionRate, including those wrapped in an
+// authz MsgExec.
+type MinCommissionDecorator struct{}
+
+func NewMinCommissionDecorator() MinCommissionDecorator {
+	return MinCommissionDecorator{}
+}
+
+func (MinCommissionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := validateCommission(tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func validateCommission(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgCreateValidator:
+			if msg.Commission.Rate.LT(MinCommissionRate) {
+				return errorsmod.Wrapf(ErrCommissionTooLow, "rate %s, minimum %s", msg.Commission.Rate, MinCommissionRate)
+			}
+		case *stakingtypes.MsgEditValidator:
+			// a nil rate means the commission is left unchanged
+			if msg.CommissionRate != nil && msg.CommissionRate.LT(MinCommissionRate) {
+				return errorsmod.Wrapf(ErrCommissionTooLow, "rate %s, minimum %s", msg.CommissionRate, MinCommissionRate)
+			}
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := validateCommission(innerMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -30,14 +30,21 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	sdkante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
+	ibctestingtypes "github.com/cosmos/ibc-go/v7/testing/types"
 	"github.com/spf13/cast"
 
+	"github.com/fatal-fruit/proton/app/ante"
 	"github.com/fatal-fruit/proton/app/keepers"
+	"github.com/fatal-fruit/proton/app/post"
+	feepolicytypes "github.com/fatal-fruit/proton/x/feepolicy/types"
 )
 
 const (
@@ -63,6 +70,7 @@ type ProtonApp struct {
 	appCodec          codec.Codec
 	txConfig          client.TxConfig
 	interfaceRegistry types.InterfaceRegistry
+	homePath          string
 
 	// the module manager
 	ModuleManager *module.Manager
@@ -115,6 +123,7 @@ func NewProtonApp(
 		appCodec:          appCodec,
 		txConfig:          txConfig,
 		interfaceRegistry: interfaceRegistry,
+		homePath:          homePath,
 	}
 
 	app.AppKeepers = keepers.NewAppKeeper(
@@ -207,6 +216,11 @@ func NewProtonApp(
 	// upgrade.
 	// To read more about tips:
 	// https://docs.cosmos.network/main/core/tips.html
+	app.setAnteHandler(txConfig)
+	app.setPostHandler()
+
+	app.setupUpgradeHandlers()
+	app.setupUpgradeStoreLoaders()
 
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
@@ -218,11 +232,51 @@ func NewProtonApp(
 	return app
 }
 
+func (app *ProtonApp) setAnteHandler(txConfig client.TxConfig) {
+	anteHandler, err := ante.NewAnteHandler(
+		ante.HandlerOptions{
+			HandlerOptions: sdkante.HandlerOptions{
+				AccountKeeper:   app.AccountKeeper,
+				BankKeeper:      app.BankKeeper,
+				FeegrantKeeper:  app.FeeGrantKeeper,
+				SignModeHandler: txConfig.SignModeHandler(),
+				SigGasConsumer:  sdkante.DefaultSigVerificationGasConsumer,
+			},
+			IBCKeeper:       app.IBCKeeper,
+			GlobalFeeKeeper: app.GlobalFeeKeeper,
+			FeeMarketKeeper: app.FeeMarketKeeper,
+			CircuitKeeper:   app.CircuitKeeper,
+			GroupKeeper:     app.GroupKeeper,
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	app.SetAnteHandler(anteHandler)
+}
+
+func (app *ProtonApp) setPostHandler() {
+	postHandler, err := post.NewPostHandler(
+		post.HandlerOptions{
+			BankKeeper:      app.BankKeeper,
+			FeeMarketKeeper: app.FeeMarketKeeper,
+			RefundKeeper:    app.RefundKeeper,
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	app.SetPostHandler(postHandler)
+}
+
 // Name returns the name of the App
 func (app *ProtonApp) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block
 func (app *ProtonApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	BeginBlockForks(ctx, app)
 	return app.ModuleManager.BeginBlock(ctx, req)
 }
 
@@ -241,7 +295,19 @@ func (app *ProtonApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) ab
 	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	if err := genesisState.ValidateNotPartial(); err != nil {
+		panic(err)
+	}
 	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap())
+
+	if bz, ok := genesisState[StreamingGenesisKey]; ok {
+		var streaming StreamingGenesis
+		if err := json.Unmarshal(bz, &streaming); err != nil {
+			panic(err)
+		}
+		return app.initGenesisFromDir(ctx, streaming)
+	}
+
 	return app.ModuleManager.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
@@ -323,6 +389,31 @@ func (app *ProtonApp) RegisterTendermintService(clientCtx client.Context) {
 	)
 }
 
+// GetBaseApp implements the TestingApp interface.
+func (app *ProtonApp) GetBaseApp() *baseapp.BaseApp {
+	return app.BaseApp
+}
+
+// GetStakingKeeper implements the TestingApp interface.
+func (app *ProtonApp) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.StakingKeeper
+}
+
+// GetIBCKeeper implements the TestingApp interface.
+func (app *ProtonApp) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper implements the TestingApp interface.
+func (app *ProtonApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig implements the TestingApp interface.
+func (app *ProtonApp) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 func (app *ProtonApp) RegisterNodeService(clientCtx client.Context) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
 }
@@ -348,6 +439,9 @@ func BlockedAddresses() map[string]bool {
 
 	// allow the following addresses to receive funds
 	delete(modAccAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// the fee policy budgets are drawn from the community pool and the unpaid
+	// relayer fees are refunded to them
+	delete(modAccAddrs, authtypes.NewModuleAddress(feepolicytypes.ModuleName).String())
 
 	return modAccAddrs
 }
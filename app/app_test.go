@@ -0,0 +1,92 @@
+package app_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+)
+
+func TestSetup(t *testing.T) {
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{ChainID: app.TestChainID})
+
+	require.Equal(t, int64(1), protonApp.LastBlockHeight())
+	require.Len(t, protonApp.StakingKeeper.GetBondedValidatorsByPower(ctx), 1)
+}
+
+func TestSignAndDeliver(t *testing.T) {
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{ChainID: app.TestChainID})
+
+	privs, addrs := app.AddTestAddrs(t, protonApp, ctx, 2, sdk.NewInt(1_000_000))
+	sendAmt := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	msg := banktypes.NewMsgSend(addrs[0], addrs[1], sendAmt)
+
+	acc := protonApp.AccountKeeper.GetAccount(ctx, addrs[0])
+	_, _, err := app.SignAndDeliver(t, protonApp.TxConfig(), protonApp.BaseApp, []sdk.Msg{msg}, fees,
+		app.TestChainID, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, true, privs[0])
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewInt(1_000_100), protonApp.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom).Amount)
+
+	// replaying the same sequence fails
+	_, _, err = app.SignAndDeliver(t, protonApp.TxConfig(), protonApp.BaseApp, []sdk.Msg{msg}, fees,
+		app.TestChainID, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, false, privs[0])
+	require.Error(t, err)
+}
+
+// TestKeepersDoNotShareStoreKeys fails if two keepers of the app hold the same
+// KV store key, so they would read and write each other's store.
+func TestKeepersDoNotShareStoreKeys(t *testing.T) {
+	protonApp := app.Setup(t)
+
+	storeKeys := make(map[uintptr]string)
+	for name, key := range protonApp.GetKVStoreKey() {
+		storeKeys[reflect.ValueOf(key).Pointer()] = name
+	}
+
+	owners := make(map[string]string)
+	keepers := reflect.ValueOf(protonApp.AppKeepers)
+	for i := 0; i < keepers.NumField(); i++ {
+		field := keepers.Type().Field(i)
+		// scoped keepers are views on the capability store
+		if !field.IsExported() || strings.HasPrefix(field.Name, "Scoped") {
+			continue
+		}
+
+		keeper := reflect.Indirect(keepers.Field(i))
+		if keeper.Kind() != reflect.Struct {
+			continue
+		}
+
+		for j := 0; j < keeper.NumField(); j++ {
+			value := keeper.Field(j)
+			if value.Kind() == reflect.Interface && !value.IsNil() {
+				value = value.Elem()
+			}
+			if value.Kind() != reflect.Ptr || value.IsNil() {
+				continue
+			}
+
+			storeKey, ok := storeKeys[value.Pointer()]
+			if !ok {
+				continue
+			}
+			if owner, shared := owners[storeKey]; shared && owner != field.Name {
+				t.Errorf("%s and %s share the %s store key", owner, field.Name, storeKey)
+			}
+			owners[storeKey] = field.Name
+		}
+	}
+
+	require.Equal(t, "ConsensusParamsKeeper", owners[consensusparamtypes.StoreKey])
+}
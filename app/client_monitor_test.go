@@ -0,0 +1,73 @@
+package app_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	clientmonitortypes "github.com/fatal-fruit/proton/x/clientmonitor/types"
+)
+
+func TestClientMonitor(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2)))
+	coordinator.SetupClients(path)
+
+	chainA := path.EndpointA.Chain
+	k := chainA.App.(*app.ProtonApp).ClientMonitorKeeper
+	clientID := path.EndpointA.ClientID
+
+	// check the clients every block
+	require.NoError(t, k.SetParams(chainA.GetContext(), clientmonitortypes.NewParams(clientmonitortypes.DefaultWarningThreshold, 1)))
+	coordinator.CommitBlock(chainA)
+
+	expiry := func() clientmonitortypes.ClientExpiry {
+		res, err := k.ClientExpiry(sdk.WrapSDKContext(chainA.GetContext()), &clientmonitortypes.QueryClientExpiryRequest{ClientId: clientID})
+		require.NoError(t, err)
+		return res.Expiry
+	}
+	requireStatus := func(status clientmonitortypes.ClientStatus) {
+		require.Equal(t, status, expiry().Status)
+		require.Equal(t, status, k.GetClientStatus(chainA.GetContext(), clientID))
+	}
+	requireStatus(clientmonitortypes.ClientStatusActive)
+	require.Equal(t, path.EndpointB.Chain.ChainID, expiry().ChainId)
+	trustingPeriod := expiry().TrustingPeriod
+
+	// the client is reported once it enters the last quarter of its trusting
+	// period
+	coordinator.IncrementTimeBy(trustingPeriod * 4 / 5)
+	coordinator.CommitNBlocks(chainA, 2)
+	requireStatus(clientmonitortypes.ClientStatusExpiring)
+
+	res, err := k.ClientExpiries(sdk.WrapSDKContext(chainA.GetContext()), &clientmonitortypes.QueryClientExpiriesRequest{Status: clientmonitortypes.ClientStatusExpiring})
+	require.NoError(t, err)
+	require.Len(t, res.Expiries, 1)
+	res, err = k.ClientExpiries(sdk.WrapSDKContext(chainA.GetContext()), &clientmonitortypes.QueryClientExpiriesRequest{Status: clientmonitortypes.ClientStatusExpired})
+	require.NoError(t, err)
+	require.Empty(t, res.Expiries)
+
+	// a status change emits an event, an unchanged status does not
+	ctx := chainA.GetContext().WithEventManager(sdk.NewEventManager())
+	k.SetClientStatus(ctx, clientID, clientmonitortypes.ClientStatusActive)
+	k.CheckClients(ctx)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, clientmonitortypes.EventTypeClientStatus, events[0].Type)
+	require.Contains(t, events[0].Attributes, sdk.NewAttribute(clientmonitortypes.AttributeKeyStatus, clientmonitortypes.ClientStatusExpiring.String()).ToKVPair())
+	k.CheckClients(ctx)
+	require.Len(t, ctx.EventManager().Events(), 1)
+
+	// updating the client makes it active again
+	require.NoError(t, path.EndpointA.UpdateClient())
+	coordinator.CommitBlock(chainA)
+	requireStatus(clientmonitortypes.ClientStatusActive)
+
+	// the client expires once its trusting period passed without update
+	coordinator.IncrementTimeBy(trustingPeriod)
+	coordinator.CommitNBlocks(chainA, 2)
+	requireStatus(clientmonitortypes.ClientStatusExpired)
+}
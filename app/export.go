@@ -2,11 +2,16 @@ package app
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"os"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -17,6 +22,74 @@ import (
 func (app *ProtonApp) ExportAppStateAndValidators(
 	forZeroHeight bool, jailAllowedAddrs []string, modulesToExport []string,
 ) (servertypes.ExportedApp, error) {
+	return app.exportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport,
+		func(ctx sdk.Context, genState map[string]json.RawMessage) (json.RawMessage, error) {
+			for moduleName, bz := range app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, modulesToExport) {
+				genState[moduleName] = bz
+			}
+
+			return json.MarshalIndent(genState, "", "  ")
+		},
+	)
+}
+
+// ExportAppStateAndValidatorsToDir exports the state of the application like
+// ExportAppStateAndValidators, but streams the genesis of each module to its
+// own file in dir, one module at a time. A relative dir is resolved against
+// the home directory and kept relative in the returned app state, which only
+// points to dir and is read back by InitChainer.
+func (app *ProtonApp) ExportAppStateAndValidatorsToDir(
+	forZeroHeight bool, jailAllowedAddrs []string, modulesToExport []string, dir string,
+) (servertypes.ExportedApp, error) {
+	return app.exportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport,
+		func(ctx sdk.Context, genState map[string]json.RawMessage) (json.RawMessage, error) {
+			path := app.streamingDir(dir)
+			if err := os.MkdirAll(path, 0o755); err != nil {
+				return nil, err
+			}
+
+			if len(modulesToExport) == 0 {
+				modulesToExport = app.ModuleManager.OrderExportGenesis
+			}
+
+			streaming := StreamingGenesis{Dir: dir}
+			for _, moduleName := range modulesToExport {
+				genesisModule, ok := app.ModuleManager.Modules[moduleName].(module.HasGenesis)
+				if !ok {
+					continue
+				}
+
+				bz := genesisModule.ExportGenesis(ctx, app.appCodec)
+				if err := os.WriteFile(StreamingGenesisFile(path, moduleName), bz, 0o600); err != nil {
+					return nil, err
+				}
+				streaming.Modules = append(streaming.Modules, NewStreamingModule(moduleName, bz))
+			}
+
+			bz, err := json.Marshal(streaming)
+			if err != nil {
+				return nil, err
+			}
+			genState[StreamingGenesisKey] = bz
+
+			return json.MarshalIndent(genState, "", "  ")
+		},
+	)
+}
+
+// exportAppStateAndValidators prepares the export context and lets
+// writeAppState export the module states into the given partial genesis.
+func (app *ProtonApp) exportAppStateAndValidators(
+	forZeroHeight bool, jailAllowedAddrs []string, modulesToExport []string,
+	writeAppState func(ctx sdk.Context, genState map[string]json.RawMessage) (json.RawMessage, error),
+) (servertypes.ExportedApp, error) {
+	// fail before any work is done if a module is unknown
+	for _, moduleName := range modulesToExport {
+		if _, ok := app.ModuleManager.Modules[moduleName]; !ok {
+			return servertypes.ExportedApp{}, fmt.Errorf("module %s does not exist", moduleName)
+		}
+	}
+
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
 
@@ -28,8 +101,16 @@ func (app *ProtonApp) ExportAppStateAndValidators(
 		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
 	}
 
-	genState := app.ModuleManager.ExportGenesis(ctx, app.appCodec)
-	appState, err := json.MarshalIndent(genState, "", "  ")
+	genState := make(map[string]json.RawMessage)
+	if len(modulesToExport) > 0 {
+		marker, err := json.Marshal(modulesToExport)
+		if err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+		genState[PartialExportKey] = marker
+	}
+
+	appState, err := writeAppState(ctx, genState)
 	if err != nil {
 		return servertypes.ExportedApp{}, err
 	}
@@ -74,8 +155,10 @@ func (app *ProtonApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs
 
 	// withdraw all validator commission
 	app.StakingKeeper.IterateValidators(ctx, func(_ int64, val stakingtypes.ValidatorI) (stop bool) {
+		// validators which earned no commission since their last withdrawal
+		// have nothing to withdraw
 		_, err := app.DistrKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
-		if err != nil {
+		if err != nil && !errors.Is(err, distrtypes.ErrNoValidatorCommission) {
 			panic(err)
 		}
 		return false
@@ -157,7 +240,7 @@ func (app *ProtonApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs
 	counter := int16(0)
 
 	for ; iter.Valid(); iter.Next() {
-		addr := sdk.ValAddress(iter.Key()[1:])
+		addr := sdk.ValAddress(stakingtypes.AddressFromValidatorsKey(iter.Key()))
 		validator, found := app.StakingKeeper.GetValidator(ctx, addr)
 		if !found {
 			panic("expected validator, not found")
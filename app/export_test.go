@@ -0,0 +1,87 @@
+package app_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+)
+
+func TestExportModules(t *testing.T) {
+	protonApp := app.Setup(t)
+	protonApp.Commit()
+
+	modules := []string{banktypes.ModuleName, stakingtypes.ModuleName}
+	exported, err := protonApp.ExportAppStateAndValidators(false, nil, modules)
+	require.NoError(t, err)
+
+	var genesisState app.GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &genesisState))
+	require.Len(t, genesisState, 3)
+	require.Contains(t, genesisState, banktypes.ModuleName)
+	require.Contains(t, genesisState, stakingtypes.ModuleName)
+	require.Error(t, genesisState.ValidateNotPartial())
+
+	// a partial export must not initialize a chain
+	newApp, _ := app.SetupTestingApp()
+	require.PanicsWithError(t, genesisState.ValidateNotPartial().Error(), func() {
+		newApp.InitChain(abci.RequestInitChain{AppStateBytes: exported.AppState})
+	})
+
+	_, err = protonApp.ExportAppStateAndValidators(false, nil, []string{"unknown"})
+	require.Error(t, err)
+
+	exported, err = protonApp.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+	var fullGenesisState app.GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &fullGenesisState))
+	require.NoError(t, fullGenesisState.ValidateNotPartial())
+}
+
+func TestStreamingExport(t *testing.T) {
+	protonApp := app.Setup(t)
+	protonApp.Commit()
+
+	dir := t.TempDir()
+	exported, err := protonApp.ExportAppStateAndValidatorsToDir(false, nil, nil, dir)
+	require.NoError(t, err)
+
+	var genesisState app.GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &genesisState))
+	require.Len(t, genesisState, 1)
+	require.FileExists(t, app.StreamingGenesisFile(dir, banktypes.ModuleName))
+
+	newApp, _ := app.SetupTestingApp()
+	res := newApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: exported.ConsensusParams,
+		AppStateBytes:   exported.AppState,
+	})
+	require.Len(t, res.Validators, len(exported.Validators))
+
+	ctx := protonApp.NewContext(true, tmproto.Header{})
+	newCtx := newApp.(*app.ProtonApp).NewContext(false, tmproto.Header{})
+	require.Equal(t, protonApp.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom), newApp.(*app.ProtonApp).BankKeeper.GetSupply(newCtx, sdk.DefaultBondDenom))
+
+	// the module files are not covered by the genesis hash, so a file that
+	// does not match the genesis must not initialize a chain
+	bankFile := app.StreamingGenesisFile(dir, banktypes.ModuleName)
+	bz, err := os.ReadFile(bankFile)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(bankFile, append(bz, ' '), 0o600))
+
+	newApp, _ = app.SetupTestingApp()
+	require.Panics(t, func() {
+		newApp.InitChain(abci.RequestInitChain{
+			ConsensusParams: exported.ConsensusParams,
+			AppStateBytes:   exported.AppState,
+		})
+	})
+}
@@ -0,0 +1,137 @@
+package app_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	feepolicykeeper "github.com/fatal-fruit/proton/x/feepolicy/keeper"
+	feepolicytypes "github.com/fatal-fruit/proton/x/feepolicy/types"
+)
+
+func TestFeePolicy(t *testing.T) {
+	feeVersion := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+		FeeVersion: ibcfeetypes.Version,
+		AppVersion: transfertypes.Version,
+	}))
+
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	path := newTransferPath(coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2)), feeVersion)
+	coordinator.Setup(path)
+
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	protonApp := chainA.App.(*app.ProtonApp)
+	k := protonApp.FeePolicyKeeper
+	msgServer := feepolicykeeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	fee := ibcfeetypes.NewFee(stake(10), stake(5), stake(3))
+	incentive := func() feepolicytypes.ChannelIncentive {
+		res, err := k.ChannelIncentive(sdk.WrapSDKContext(chainA.GetContext()), &feepolicytypes.QueryChannelIncentiveRequest{PortId: portID, ChannelId: channelID})
+		require.NoError(t, err)
+		// the module account holds the budget, the fee module the escrowed fees
+		require.Equal(t, res.Incentive.Budget, protonApp.BankKeeper.GetAllBalances(chainA.GetContext(), k.GetModuleAddress()))
+		return res.Incentive
+	}
+	transfer := func(timeoutHeight clienttypes.Height) channeltypes.Packet {
+		res, err := chainA.SendMsgs(transfertypes.NewMsgTransfer(
+			portID,
+			channelID,
+			sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			chainA.SenderAccount.GetAddress().String(),
+			chainB.SenderAccount.GetAddress().String(),
+			timeoutHeight,
+			0,
+			"",
+		))
+		require.NoError(t, err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		require.NoError(t, err)
+
+		// an attached incentive is reported once
+		incentivized := 0
+		for _, event := range res.GetEvents() {
+			if event.Type == ibcfeetypes.EventTypeIncentivizedPacket {
+				incentivized++
+			}
+		}
+		require.LessOrEqual(t, incentivized, 1)
+		return packet
+	}
+	feesInEscrow := func(packet channeltypes.Packet) bool {
+		packetID := channeltypes.NewPacketID(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+		return protonApp.IBCFeeKeeper.HasFeesInEscrow(chainA.GetContext(), packetID)
+	}
+
+	// governance may only fund incentives with what the community pool holds
+	ctx := chainA.GetContext()
+	communityPool, _ := protonApp.DistrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+	_, err := msgServer.SetChannelIncentive(ctx, &feepolicytypes.MsgSetChannelIncentive{
+		Authority: authority, PortId: portID, ChannelId: channelID, Fee: fee, Amount: communityPool.Add(stake(1)...),
+	})
+	require.Error(t, err)
+
+	require.NoError(t, protonApp.DistrKeeper.FundCommunityPool(ctx, stake(100), chainA.SenderAccount.GetAddress()))
+	_, err = msgServer.SetChannelIncentive(ctx, &feepolicytypes.MsgSetChannelIncentive{
+		Authority: chainA.SenderAccount.GetAddress().String(), PortId: portID, ChannelId: channelID, Fee: fee, Amount: stake(36),
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.SetChannelIncentive(ctx, &feepolicytypes.MsgSetChannelIncentive{
+		Authority: authority, PortId: portID, ChannelId: channelID, Fee: fee, Amount: stake(36),
+	})
+	require.NoError(t, err)
+	coordinator.CommitBlock(chainA)
+	require.Equal(t, stake(36), incentive().Budget)
+
+	// the incentive is attached to the packet, without a counterparty payee
+	// the receive fee is refunded to the budget on acknowledgement
+	packet := transfer(clienttypes.NewHeight(1, 110))
+	require.True(t, feesInEscrow(packet))
+	require.Equal(t, stake(18), incentive().Budget)
+	require.Equal(t, stake(18), incentive().Escrowed)
+	require.NoError(t, path.RelayPacket(packet))
+	require.False(t, feesInEscrow(packet))
+	require.Equal(t, stake(31), incentive().Budget)
+	require.True(t, incentive().Escrowed.IsZero())
+	require.Equal(t, stake(5), incentive().Spent)
+
+	// the receive and acknowledgement fees are refunded on timeout
+	packet = transfer(clienttypes.GetSelfHeight(chainB.GetContext()).Increment().(clienttypes.Height))
+	require.True(t, feesInEscrow(packet))
+	coordinator.CommitNBlocks(chainB, 2)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+	require.Equal(t, stake(28), incentive().Budget)
+	require.Equal(t, stake(8), incentive().Spent)
+
+	// packets are sent without a fee once the budget is exhausted
+	require.True(t, feesInEscrow(transfer(clienttypes.NewHeight(1, 110))))
+	require.False(t, feesInEscrow(transfer(clienttypes.NewHeight(1, 110))))
+	require.Equal(t, stake(10), incentive().Budget)
+	require.Equal(t, stake(18), incentive().Escrowed)
+
+	// removing the incentive returns its budget to the community pool
+	ctx = chainA.GetContext()
+	pool := protonApp.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	_, err = msgServer.RemoveChannelIncentive(ctx, &feepolicytypes.MsgRemoveChannelIncentive{
+		Authority: authority, PortId: portID, ChannelId: channelID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, pool.Add(sdk.NewDecCoinsFromCoins(stake(10)...)...), protonApp.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	_, found := k.GetChannelIncentive(ctx, portID, channelID)
+	require.False(t, found)
+	require.Len(t, k.ExportGenesis(ctx).Packets, 1)
+}
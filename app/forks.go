@@ -0,0 +1,23 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/app/upgrades"
+)
+
+// Forks lists the hard forks of the chain. The BeginForkLogic of each of them
+// runs at the start of its upgrade height, without a governance proposal.
+var Forks = []upgrades.Fork{}
+
+// BeginBlockForks runs the BeginForkLogic of the fork scheduled at the current
+// height, if any. At most one fork is applied per height.
+func BeginBlockForks(ctx sdk.Context, app *ProtonApp) {
+	for _, fork := range Forks {
+		if ctx.BlockHeight() == fork.UpgradeHeight {
+			ctx.Logger().Info("applying hard fork", "name", fork.UpgradeName, "height", fork.UpgradeHeight)
+			fork.BeginForkLogic(ctx, &app.AppKeepers)
+			return
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package app_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/app/keepers"
+	"github.com/fatal-fruit/proton/app/upgrades"
+)
+
+func TestBeginBlockForks(t *testing.T) {
+	protonApp := app.Setup(t)
+	protonApp.EndBlock(abci.RequestEndBlock{})
+	protonApp.Commit()
+
+	ctx := protonApp.NewContext(true, tmproto.Header{})
+	_, addrs := app.AddTestAddrs(t, protonApp, protonApp.NewUncachedContext(false, tmproto.Header{}), 2, sdk.NewInt(1000))
+	from, to := addrs[0], addrs[1]
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	bondDenom := protonApp.StakingKeeper.BondDenom(ctx)
+	minted := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500))
+	moved := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 300))
+
+	mintHeight := protonApp.LastBlockHeight() + 2
+	moveHeight := mintHeight + 2
+	var mintHeights, moveHeights []int64
+
+	forks := app.Forks
+	t.Cleanup(func() { app.Forks = forks })
+	app.Forks = []upgrades.Fork{
+		{
+			UpgradeName:   "mint",
+			UpgradeHeight: mintHeight,
+			BeginForkLogic: func(ctx sdk.Context, keepers *keepers.AppKeepers) {
+				mintHeights = append(mintHeights, ctx.BlockHeight())
+				require.NoError(t, keepers.BankKeeper.MintCoins(ctx, minttypes.ModuleName, minted))
+				require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, recipient, minted))
+			},
+		},
+		{
+			UpgradeName:   "move",
+			UpgradeHeight: moveHeight,
+			BeginForkLogic: func(ctx sdk.Context, keepers *keepers.AppKeepers) {
+				moveHeights = append(moveHeights, ctx.BlockHeight())
+				require.NoError(t, keepers.BankKeeper.SendCoins(ctx, from, to, moved))
+			},
+		},
+	}
+
+	for height := protonApp.LastBlockHeight() + 1; height <= moveHeight+2; height++ {
+		protonApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{ChainID: app.TestChainID, Height: height}})
+		protonApp.EndBlock(abci.RequestEndBlock{Height: height})
+		protonApp.Commit()
+	}
+
+	require.Equal(t, []int64{mintHeight}, mintHeights)
+	require.Equal(t, []int64{moveHeight}, moveHeights)
+
+	ctx = protonApp.NewContext(true, tmproto.Header{})
+	require.Equal(t, minted, protonApp.BankKeeper.GetAllBalances(ctx, recipient))
+	require.Equal(t, sdk.NewInt(700), protonApp.BankKeeper.GetBalance(ctx, from, bondDenom).Amount)
+	require.Equal(t, sdk.NewInt(1300), protonApp.BankKeeper.GetBalance(ctx, to, bondDenom).Amount)
+}
@@ -1,9 +1,17 @@
 package app
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 // The genesis state of the blockchain is represented here as a map of raw json
@@ -15,7 +23,124 @@ import (
 // object provided to it during init.
 type GenesisState map[string]json.RawMessage
 
+// PartialExportKey is set in the app state of an export limited to some
+// modules, listing them. Such a genesis misses the state of every other module
+// and is refused by InitChainer.
+const PartialExportKey = "partial_export"
+
+// StreamingGenesisKey holds the StreamingGenesis of an app state exported
+// with one genesis file per module.
+const StreamingGenesisKey = "streaming_genesis"
+
+// StreamingGenesis points to the directory of a streaming export. A relative
+// directory is resolved against the node's home directory. The module files
+// are not covered by the genesis hash, so the genesis records the sha256 of
+// each of them.
+type StreamingGenesis struct {
+	Dir     string            `json:"dir"`
+	Modules []StreamingModule `json:"modules"`
+}
+
+// StreamingModule is a module genesis file of a streaming export.
+type StreamingModule struct {
+	Name   string `json:"name"`
+	SHA256 string `json:"sha256"`
+}
+
+// NewStreamingModule returns the StreamingModule of moduleName, whose genesis
+// file holds bz.
+func NewStreamingModule(moduleName string, bz []byte) StreamingModule {
+	checksum := sha256.Sum256(bz)
+	return StreamingModule{
+		Name:   moduleName,
+		SHA256: hex.EncodeToString(checksum[:]),
+	}
+}
+
+// StreamingGenesisFile returns the path of the genesis file of moduleName in
+// the streaming export directory dir.
+func StreamingGenesisFile(dir, moduleName string) string {
+	return filepath.Join(dir, moduleName+".json")
+}
+
 // NewDefaultGenesisState generates the default state for the application.
 func NewDefaultGenesisState(cdc codec.JSONCodec) GenesisState {
 	return ModuleBasics.DefaultGenesis(cdc)
 }
+
+// ValidateNotPartial returns an error if the genesis state was exported for a
+// subset of the modules only.
+func (gs GenesisState) ValidateNotPartial() error {
+	bz, ok := gs[PartialExportKey]
+	if !ok {
+		return nil
+	}
+
+	var modules []string
+	if err := json.Unmarshal(bz, &modules); err != nil {
+		return fmt.Errorf("invalid %s marker: %w", PartialExportKey, err)
+	}
+
+	return fmt.Errorf("genesis was exported for modules %v only and cannot initialize a chain", modules)
+}
+
+// streamingDir resolves the directory of a streaming export against the
+// node's home directory.
+func (app *ProtonApp) streamingDir(dir string) string {
+	if filepath.IsAbs(dir) {
+		return dir
+	}
+	return filepath.Join(app.homePath, dir)
+}
+
+// initGenesisFromDir initializes the modules in init genesis order from the
+// files of a streaming export, reading one module genesis at a time. A file
+// which does not match its recorded sha256 aborts the initialization.
+func (app *ProtonApp) initGenesisFromDir(ctx sdk.Context, streaming StreamingGenesis) abci.ResponseInitChain {
+	dir := app.streamingDir(streaming.Dir)
+
+	exported := make(map[string]StreamingModule, len(streaming.Modules))
+	for _, streamingModule := range streaming.Modules {
+		exported[streamingModule.Name] = streamingModule
+	}
+
+	ctx.Logger().Info("initializing blockchain state from streaming genesis", "dir", dir)
+
+	var validatorUpdates []abci.ValidatorUpdate
+	for _, moduleName := range app.ModuleManager.OrderInitGenesis {
+		streamingModule, ok := exported[moduleName]
+		if !ok {
+			continue
+		}
+
+		genesisModule, ok := app.ModuleManager.Modules[moduleName].(module.HasGenesis)
+		if !ok {
+			continue
+		}
+
+		bz, err := os.ReadFile(StreamingGenesisFile(dir, moduleName))
+		if err != nil {
+			panic(err)
+		}
+		if NewStreamingModule(moduleName, bz) != streamingModule {
+			panic(fmt.Sprintf("genesis file of module %s does not match its sha256 %s", moduleName, streamingModule.SHA256))
+		}
+
+		moduleValUpdates := genesisModule.InitGenesis(ctx, app.appCodec, bz)
+		if len(moduleValUpdates) > 0 {
+			if len(validatorUpdates) > 0 {
+				panic("validator InitGenesis updates already set by a previous module")
+			}
+			validatorUpdates = moduleValUpdates
+		}
+	}
+
+	// a chain must initialize with a non-empty validator set
+	if len(validatorUpdates) == 0 {
+		panic(fmt.Sprintf("validator set is empty after InitGenesis, please ensure at least one validator is initialized with a delegation greater than or equal to the DefaultPowerReduction (%d)", sdk.DefaultPowerReduction))
+	}
+
+	return abci.ResponseInitChain{
+		Validators: validatorUpdates,
+	}
+}
@@ -0,0 +1,458 @@
+package app_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	ibchookstypes "github.com/fatal-fruit/proton/x/ibchooks/types"
+	ratelimittypes "github.com/fatal-fruit/proton/x/ratelimit/types"
+	transferfiltertypes "github.com/fatal-fruit/proton/x/transferfilter/types"
+)
+
+func init() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+}
+
+func newTransferPath(chainA, chainB *ibctesting.TestChain, version string) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+
+	return path
+}
+
+func TestIBCTransfer(t *testing.T) {
+	feeVersion := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+		FeeVersion: ibcfeetypes.Version,
+		AppVersion: transfertypes.Version,
+	}))
+
+	testCases := []struct {
+		name    string
+		version string
+	}{
+		{"plain transfer channel", transfertypes.Version},
+		{"fee enabled transfer channel", feeVersion},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			coordinator := ibctesting.NewCoordinator(t, 2)
+			path := newTransferPath(coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2)), tc.version)
+			coordinator.Setup(path)
+
+			chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+			amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+
+			msg := transfertypes.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				amount,
+				chainA.SenderAccount.GetAddress().String(),
+				chainB.SenderAccount.GetAddress().String(),
+				clienttypes.NewHeight(1, 110),
+				0,
+				"",
+			)
+			res, err := chainA.SendMsgs(msg)
+			require.NoError(t, err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			require.NoError(t, err)
+			require.NoError(t, path.RelayPacket(packet))
+
+			voucherDenom := transfertypes.ParseDenomTrace(
+				transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom),
+			).IBCDenom()
+			protonApp := chainB.App.(*app.ProtonApp)
+			balance := protonApp.BankKeeper.GetBalance(chainB.GetContext(), chainB.SenderAccount.GetAddress(), voucherDenom)
+			require.Equal(t, amount.Amount, balance.Amount)
+		})
+	}
+}
+
+func TestPacketForward(t *testing.T) {
+	testCases := []struct {
+		name         string
+		forwardChan  func(pathBC *ibctesting.Path) string
+		expForwarded bool
+	}{
+		{"forwarded to the third chain", func(pathBC *ibctesting.Path) string { return pathBC.EndpointA.ChannelID }, true},
+		{"refunded when the next hop fails", func(*ibctesting.Path) string { return "channel-99" }, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			coordinator := ibctesting.NewCoordinator(t, 3)
+			chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+			chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+			chainC := coordinator.GetChain(ibctesting.GetChainID(3))
+
+			pathAB := newTransferPath(chainA, chainB, transfertypes.Version)
+			coordinator.Setup(pathAB)
+			pathBC := newTransferPath(chainB, chainC, transfertypes.Version)
+			coordinator.Setup(pathBC)
+
+			sender := chainA.SenderAccount.GetAddress()
+			receiver := chainC.SenderAccount.GetAddress()
+			protonAppA := chainA.App.(*app.ProtonApp)
+			senderBalance := protonAppA.BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			memo := fmt.Sprintf(`{"forward":{"receiver":%q,"port":%q,"channel":%q}}`,
+				receiver.String(), ibctesting.TransferPort, tc.forwardChan(pathBC))
+			amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+			msg := transfertypes.NewMsgTransfer(
+				pathAB.EndpointA.ChannelConfig.PortID,
+				pathAB.EndpointA.ChannelID,
+				amount,
+				sender.String(),
+				"pfm", // overridden by the middleware on chain B
+				clienttypes.NewHeight(1, 110),
+				0,
+				memo,
+			)
+			res, err := chainA.SendMsgs(msg)
+			require.NoError(t, err)
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			require.NoError(t, err)
+
+			if !tc.expForwarded {
+				// chain B acknowledges the packet with an error right away
+				require.NoError(t, pathAB.RelayPacket(packet))
+				require.Equal(t, senderBalance, protonAppA.BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom))
+				return
+			}
+
+			// chain B receives the packet and sends it on to chain C, it
+			// acknowledges the packet once chain C did
+			require.NoError(t, pathAB.EndpointB.UpdateClient())
+			res, err = pathAB.EndpointB.RecvPacketWithResult(packet)
+			require.NoError(t, err)
+			forwarded, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			require.NoError(t, err)
+			require.NoError(t, pathBC.RelayPacket(forwarded))
+
+			ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+			require.NoError(t, pathAB.EndpointA.UpdateClient())
+			require.NoError(t, pathAB.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement()))
+			require.Equal(t, senderBalance.Sub(amount), protonAppA.BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom))
+
+			voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+				forwarded.GetDestPort(), forwarded.GetDestChannel(),
+				transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom),
+			)).IBCDenom()
+			protonAppC := chainC.App.(*app.ProtonApp)
+			balance := protonAppC.BankKeeper.GetBalance(chainC.GetContext(), receiver, voucherDenom)
+			require.Equal(t, amount.Amount, balance.Amount)
+		})
+	}
+}
+
+func TestIBCHooks(t *testing.T) {
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+
+	testCases := []struct {
+		name     string
+		memo     func(cdc codec.Codec, hookSender, recipient sdk.AccAddress, voucher sdk.Coin) string
+		expHook  bool
+		expError bool
+	}{
+		{
+			"plain memo is a plain transfer",
+			func(codec.Codec, sdk.AccAddress, sdk.AccAddress, sdk.Coin) string { return "thanks" },
+			false, false,
+		},
+		{
+			"hook spends the received tokens",
+			func(cdc codec.Codec, hookSender, recipient sdk.AccAddress, voucher sdk.Coin) string {
+				return hookMemo(t, cdc, banktypes.NewMsgSend(hookSender, recipient, sdk.NewCoins(voucher)))
+			},
+			true, false,
+		},
+		{
+			"hook cannot sign for a local account",
+			func(cdc codec.Codec, _, recipient sdk.AccAddress, voucher sdk.Coin) string {
+				return hookMemo(t, cdc, banktypes.NewMsgSend(recipient, recipient, sdk.NewCoins(voucher)))
+			},
+			true, true,
+		},
+		{
+			"failing hook reverts the transfer",
+			func(cdc codec.Codec, hookSender, recipient sdk.AccAddress, voucher sdk.Coin) string {
+				return hookMemo(t, cdc, banktypes.NewMsgSend(hookSender, recipient, sdk.NewCoins(voucher.Add(voucher))))
+			},
+			true, true,
+		},
+		{
+			"malformed hook is refused",
+			func(codec.Codec, sdk.AccAddress, sdk.AccAddress, sdk.Coin) string {
+				return `{"hooks":{"msgs":[{"@type":"/unknown"}]}}`
+			},
+			true, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			coordinator := ibctesting.NewCoordinator(t, 2)
+			path := newTransferPath(coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2)), transfertypes.Version)
+			coordinator.Setup(path)
+
+			chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+			protonAppA := chainA.App.(*app.ProtonApp)
+			protonAppB := chainB.App.(*app.ProtonApp)
+
+			sender := chainA.SenderAccount.GetAddress()
+			receiver := chainB.SenderAccount.GetAddress()
+			recipient := chainB.SenderAccounts[1].SenderAccount.GetAddress()
+			hookSender := ibchookstypes.DeriveSender(path.EndpointB.ChannelID, sender.String())
+			voucherDenom := transfertypes.ParseDenomTrace(
+				transfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+			).IBCDenom()
+			voucher := sdk.NewCoin(voucherDenom, amount.Amount)
+			senderBalance := protonAppA.BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			msg := transfertypes.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				amount,
+				sender.String(),
+				receiver.String(),
+				clienttypes.NewHeight(1, 110),
+				0,
+				tc.memo(protonAppB.AppCodec(), hookSender, recipient, voucher),
+			)
+			res, err := chainA.SendMsgs(msg)
+			require.NoError(t, err)
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			require.NoError(t, err)
+			require.NoError(t, path.RelayPacket(packet))
+
+			ctxB := chainB.GetContext()
+			switch {
+			case tc.expError:
+				// the tokens are refunded and nothing is minted on chain B
+				require.Equal(t, senderBalance, protonAppA.BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom))
+				require.True(t, protonAppB.BankKeeper.GetSupply(ctxB, voucherDenom).IsZero())
+			case tc.expHook:
+				require.Equal(t, voucher, protonAppB.BankKeeper.GetBalance(ctxB, recipient, voucherDenom))
+				require.True(t, protonAppB.BankKeeper.GetAllBalances(ctxB, hookSender).IsZero())
+				require.True(t, protonAppB.BankKeeper.GetAllBalances(ctxB, receiver).AmountOf(voucherDenom).IsZero())
+			default:
+				require.Equal(t, voucher, protonAppB.BankKeeper.GetBalance(ctxB, receiver, voucherDenom))
+			}
+		})
+	}
+}
+
+func hookMemo(t *testing.T, cdc codec.Codec, msgs ...sdk.Msg) string {
+	t.Helper()
+
+	hook := ibchookstypes.HookMemo{}
+	for _, msg := range msgs {
+		bz, err := cdc.MarshalInterfaceJSON(msg)
+		require.NoError(t, err)
+		hook.Msgs = append(hook.Msgs, bz)
+	}
+
+	bz, err := json.Marshal(map[string]ibchookstypes.HookMemo{ibchookstypes.MemoKey: hook})
+	require.NoError(t, err)
+
+	return string(bz)
+}
+
+func TestRateLimit(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	path := newTransferPath(coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2)), transfertypes.Version)
+	coordinator.Setup(path)
+
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	protonAppA := chainA.App.(*app.ProtonApp)
+	protonAppB := chainB.App.(*app.ProtonApp)
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainB.SenderAccount.GetAddress()
+
+	newMsgTransfer := func(amount int64, timeoutHeight clienttypes.Height) *transfertypes.MsgTransfer {
+		return transfertypes.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+			sender.String(),
+			receiver.String(),
+			timeoutHeight,
+			0,
+			"",
+		)
+	}
+	transfer := func(amount int64, timeoutHeight clienttypes.Height) channeltypes.Packet {
+		res, err := chainA.SendMsgs(newMsgTransfer(amount, timeoutHeight))
+		require.NoError(t, err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		require.NoError(t, err)
+		return packet
+	}
+	outflow := func() sdk.Int {
+		res, err := protonAppA.RateLimitKeeper.Flow(chainA.GetContext(), &ratelimittypes.QueryFlowRequest{
+			Denom:     sdk.DefaultBondDenom,
+			ChannelId: path.EndpointA.ChannelID,
+		})
+		require.NoError(t, err)
+		return res.Flow.Outflow
+	}
+
+	// chain A lets 1000stake out per hour over the channel
+	ctxA := chainA.GetContext()
+	supply := protonAppA.BankKeeper.GetSupply(ctxA, sdk.DefaultBondDenom).Amount
+	maxPercentSend := sdk.NewDec(1000).QuoInt(supply)
+	require.NoError(t, protonAppA.RateLimitKeeper.SetParams(ctxA, ratelimittypes.NewParams(
+		ratelimittypes.NewQuota(sdk.DefaultBondDenom, path.EndpointA.ChannelID, maxPercentSend, sdk.OneDec(), time.Hour),
+	)))
+	coordinator.CommitBlock(chainA)
+	threshold := sdk.NewDecFromInt(supply).Mul(maxPercentSend).TruncateInt().Int64()
+
+	voucherDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+
+	// a send within quota goes through
+	packet := transfer(threshold/2, clienttypes.NewHeight(1, 110))
+	require.Equal(t, sdk.NewInt(threshold/2), outflow())
+	require.NoError(t, path.RelayPacket(packet))
+
+	// a send over quota is refused
+	_, err := protonAppA.TransferKeeper.Transfer(chainA.GetContext(), newMsgTransfer(threshold/2+1, clienttypes.NewHeight(1, 110)))
+	require.ErrorIs(t, err, ratelimittypes.ErrQuotaExceeded)
+
+	// a refunded send frees its outflow again
+	packet = transfer(threshold/2, clienttypes.GetSelfHeight(chainB.GetContext()).Increment().(clienttypes.Height))
+	require.Equal(t, sdk.NewInt(threshold), outflow())
+	coordinator.CommitNBlocks(chainB, 2)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+	require.Equal(t, sdk.NewInt(threshold/2), outflow())
+
+	// a new window starts once the current one ended
+	coordinator.IncrementTimeBy(time.Hour)
+	require.True(t, outflow().IsZero())
+
+	// chain B lets a tenth of the voucher supply in, it refuses the rest and
+	// chain A refunds the sender
+	ctxB := chainB.GetContext()
+	require.NoError(t, protonAppB.RateLimitKeeper.SetParams(ctxB, ratelimittypes.NewParams(
+		ratelimittypes.NewQuota(voucherDenom, path.EndpointB.ChannelID, sdk.OneDec(), sdk.NewDecWithPrec(1, 1), time.Hour),
+	)))
+	coordinator.CommitBlock(chainB)
+	senderBalance := protonAppA.BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	packet = transfer(threshold/2, clienttypes.NewHeight(1, 1000))
+	require.NoError(t, path.RelayPacket(packet))
+	require.Equal(t, senderBalance, protonAppA.BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom))
+	require.True(t, outflow().IsZero())
+}
+
+func TestTransferFilter(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	path := newTransferPath(coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2)), transfertypes.Version)
+	coordinator.Setup(path)
+
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	protonAppA := chainA.App.(*app.ProtonApp)
+	protonAppB := chainB.App.(*app.ProtonApp)
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainB.SenderAccount.GetAddress()
+	channelA, channelB := path.EndpointA.ChannelID, path.EndpointB.ChannelID
+
+	msgTransfer := transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		channelA,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		sender.String(),
+		receiver.String(),
+		clienttypes.NewHeight(1, 1000),
+		0,
+		"",
+	)
+	voucherDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, channelB, sdk.DefaultBondDenom),
+	).IBCDenom()
+	setParams := func(chain *ibctesting.TestChain, params transferfiltertypes.Params) {
+		require.NoError(t, chain.App.(*app.ProtonApp).TransferFilterKeeper.SetParams(chain.GetContext(), params))
+		coordinator.CommitBlock(chain)
+	}
+
+	// chain A disables sends over the channel
+	setParams(chainA, transferfiltertypes.NewParams(false, []transferfiltertypes.ChannelFilter{
+		transferfiltertypes.NewChannelFilter(channelA, false, true, nil, nil),
+	}, nil))
+	_, err := protonAppA.TransferKeeper.Transfer(chainA.GetContext(), msgTransfer)
+	require.ErrorIs(t, err, transferfiltertypes.ErrChannelNotAllowed)
+
+	// chain A only lets the bond denom out, chain B only receives over
+	// filtered channels and refuses the tokens, chain A refunds the sender
+	setParams(chainA, transferfiltertypes.NewParams(false, []transferfiltertypes.ChannelFilter{
+		transferfiltertypes.NewChannelFilter(channelA, true, true, []string{sdk.DefaultBondDenom}, nil),
+	}, nil))
+	setParams(chainB, transferfiltertypes.NewParams(true, nil, nil))
+	senderBalance := protonAppA.BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	res, err := chainA.SendMsgs(msgTransfer)
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+	require.Equal(t, senderBalance, protonAppA.BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom))
+	require.True(t, protonAppB.BankKeeper.GetBalance(chainB.GetContext(), receiver, voucherDenom).IsZero())
+
+	// chain B allows the voucher over the channel
+	setParams(chainB, transferfiltertypes.NewParams(true, []transferfiltertypes.ChannelFilter{
+		transferfiltertypes.NewChannelFilter(channelB, true, true, []string{voucherDenom}, nil),
+	}, nil))
+	res, err = chainA.SendMsgs(msgTransfer)
+	require.NoError(t, err)
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+	require.Equal(t, int64(100), protonAppB.BankKeeper.GetBalance(chainB.GetContext(), receiver, voucherDenom).Amount.Int64())
+
+	// chain B blocks the voucher on all channels, it can neither send it back
+	// nor receive more of it
+	setParams(chainB, transferfiltertypes.NewParams(false, nil, []string{voucherDenom}))
+	_, err = protonAppB.TransferKeeper.Transfer(chainB.GetContext(), transfertypes.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID,
+		channelB,
+		sdk.NewInt64Coin(voucherDenom, 100),
+		receiver.String(),
+		sender.String(),
+		clienttypes.NewHeight(1, 1000),
+		0,
+		"",
+	))
+	require.ErrorIs(t, err, transferfiltertypes.ErrDenomNotAllowed)
+
+	allowed, err := protonAppB.TransferFilterKeeper.TransferAllowed(chainB.GetContext(), &transferfiltertypes.QueryTransferAllowedRequest{
+		ChannelId: channelB,
+		Denom:     voucherDenom,
+	})
+	require.NoError(t, err)
+	require.False(t, allowed.SendAllowed)
+	require.False(t, allowed.ReceiveAllowed)
+}
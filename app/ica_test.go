@@ -0,0 +1,173 @@
+package app_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	icaauthtypes "github.com/fatal-fruit/proton/x/icaauth/types"
+)
+
+// registerInterchainAccount registers an interchain account of owner on chain A
+// through x/icaauth, completes the channel handshake and returns the account
+// address on chain B.
+func registerInterchainAccount(t *testing.T, path *ibctesting.Path, owner string, deliver func(sdk.Msg) sdk.Events) string {
+	t.Helper()
+
+	events := deliver(icaauthtypes.NewMsgRegisterInterchainAccount(owner, path.EndpointA.ConnectionID, ""))
+	channelID, err := ibctesting.ParseChannelIDFromEvents(events)
+	require.NoError(t, err)
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	require.NoError(t, err)
+
+	// the controller stack negotiates the channel version, fee middleware
+	// included, on channel opening
+	path.EndpointA.ChannelID = channelID
+	path.EndpointA.ChannelConfig.PortID = portID
+	version := path.EndpointA.GetChannel().Version
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+
+	require.NoError(t, path.EndpointB.ChanOpenTry())
+	require.NoError(t, path.EndpointA.ChanOpenAck())
+	require.NoError(t, path.EndpointB.ChanOpenConfirm())
+
+	protonApp := path.EndpointA.Chain.App.(*app.ProtonApp)
+	address, found := protonApp.ICAAuthKeeper.GetInterchainAccountAddress(path.EndpointA.Chain.GetContext(), owner, path.EndpointA.ConnectionID)
+	require.True(t, found)
+
+	return address
+}
+
+// submitTx sends msgs for the interchain account of owner on chain A and
+// returns the sent packet.
+func submitTx(t *testing.T, path *ibctesting.Path, owner string, relativeTimeout uint64, deliver func(sdk.Msg) sdk.Events, msgs ...sdk.Msg) channeltypes.Packet {
+	t.Helper()
+
+	msg, err := icaauthtypes.NewMsgSubmitTx(owner, path.EndpointA.ConnectionID, msgs, relativeTimeout)
+	require.NoError(t, err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(deliver(msg))
+	require.NoError(t, err)
+
+	return packet
+}
+
+func requirePacketStatus(t *testing.T, path *ibctesting.Path, packet channeltypes.Packet, status icaauthtypes.PacketStatus) icaauthtypes.PacketRecord {
+	t.Helper()
+
+	protonApp := path.EndpointA.Chain.App.(*app.ProtonApp)
+	record, found := protonApp.ICAAuthKeeper.GetPacket(path.EndpointA.Chain.GetContext(), packet.SourceChannel, packet.Sequence)
+	require.True(t, found)
+	require.Equal(t, status, record.Status)
+
+	return record
+}
+
+// timeoutOrderedPacket times packet out on chain A. Endpoint.TimeoutPacket
+// reads the next receive sequence from the source port and channel, which
+// differ from the destination ones on interchain account channels.
+func timeoutOrderedPacket(path *ibctesting.Path, packet channeltypes.Packet) error {
+	chainB := path.EndpointB.Chain
+	proof, proofHeight := path.EndpointB.QueryProof(host.NextSequenceRecvKey(packet.DestinationPort, packet.DestinationChannel))
+	nextSeqRecv, _ := chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel)
+
+	chainA := path.EndpointA.Chain
+	_, err := chainA.SendMsgs(channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, chainA.SenderAccount.GetAddress().String()))
+	return err
+}
+
+func TestInterchainAccounts(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	// deliverTx sends msgs signed by the sender account of chain A
+	deliverTx := func(msg sdk.Msg) sdk.Events {
+		res, err := chainA.SendMsgs(msg)
+		require.NoError(t, err)
+		return res.GetEvents()
+	}
+
+	// deliverGov executes msgs like the gov module does for passed proposals
+	deliverGov := func(msg sdk.Msg) sdk.Events {
+		protonApp := chainA.App.(*app.ProtonApp)
+		ctx := chainA.GetContext()
+		handler := protonApp.MsgServiceRouter().Handler(msg)
+		res, err := handler(ctx, msg)
+		require.NoError(t, err)
+		coordinator.CommitBlock(chainA)
+		return res.GetEvents()
+	}
+
+	testCases := []struct {
+		name    string
+		owner   string
+		deliver func(sdk.Msg) sdk.Events
+	}{
+		{"account owner", chainA.SenderAccount.GetAddress().String(), deliverTx},
+		{"governance owner", authtypes.NewModuleAddress(govtypes.ModuleName).String(), deliverGov},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			path := ibctesting.NewPath(chainA, chainB)
+			coordinator.SetupConnections(path)
+
+			icaAddress := registerInterchainAccount(t, path, tc.owner, tc.deliver)
+
+			// fund the interchain account on the host chain
+			funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+			_, err := chainB.SendMsgs(banktypes.NewMsgSend(chainB.SenderAccount.GetAddress(), sdk.MustAccAddressFromBech32(icaAddress), funds))
+			require.NoError(t, err)
+
+			recipient := chainB.SenderAccounts[1].SenderAccount.GetAddress()
+			protonAppB := chainB.App.(*app.ProtonApp)
+			balance := protonAppB.BankKeeper.GetBalance(chainB.GetContext(), recipient, sdk.DefaultBondDenom)
+
+			// the host executes the msgs and acknowledges the packet
+			amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+			send := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(icaAddress), recipient, sdk.NewCoins(amount))
+			packet := submitTx(t, path, tc.owner, icatypes.DefaultRelativePacketTimeoutTimestamp, tc.deliver, send)
+			requirePacketStatus(t, path, packet, icaauthtypes.PacketStatusPending)
+
+			require.NoError(t, path.RelayPacket(packet))
+			requirePacketStatus(t, path, packet, icaauthtypes.PacketStatusAcknowledged)
+			require.Equal(t, balance.Add(amount), protonAppB.BankKeeper.GetBalance(chainB.GetContext(), recipient, sdk.DefaultBondDenom))
+
+			// the host fails to execute the msgs
+			overdraft := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(icaAddress), recipient, funds)
+			packet = submitTx(t, path, tc.owner, icatypes.DefaultRelativePacketTimeoutTimestamp, tc.deliver, overdraft)
+			require.NoError(t, path.RelayPacket(packet))
+			record := requirePacketStatus(t, path, packet, icaauthtypes.PacketStatusFailed)
+			require.NotEmpty(t, record.Error)
+
+			// the packet times out before it is relayed
+			packet = submitTx(t, path, tc.owner, 1, tc.deliver, send)
+			coordinator.CommitBlock(chainB)
+			require.NoError(t, path.EndpointA.UpdateClient())
+			require.NoError(t, timeoutOrderedPacket(path, packet))
+			requirePacketStatus(t, path, packet, icaauthtypes.PacketStatusTimedOut)
+
+			protonAppA := chainA.App.(*app.ProtonApp)
+			res, err := protonAppA.ICAAuthKeeper.Packets(chainA.GetContext(), &icaauthtypes.QueryPacketsRequest{Owner: tc.owner})
+			require.NoError(t, err)
+			require.Len(t, res.Packets, 3)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	solomachine "github.com/cosmos/ibc-go/v7/modules/light-clients/06-solomachine"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	icqtypes "github.com/fatal-fruit/proton/x/icq/types"
+)
+
+const (
+	// icqControllerPort and icqControllerChannel identify the channel end of
+	// the solo machine acting as interchain query controller.
+	icqControllerPort    = "icqcontroller"
+	icqControllerChannel = "channel-on-solomachine"
+)
+
+// proveControllerChannel returns the solo machine proof of its channel end in
+// state, connected to hostChannelID on proton.
+func proveControllerChannel(t *testing.T, chain *ibctesting.TestChain, solo *ibctesting.Solomachine, state channeltypes.State, hostChannelID string) []byte {
+	t.Helper()
+
+	channel := channeltypes.NewChannel(state, channeltypes.UNORDERED, channeltypes.NewCounterparty(icqtypes.PortID, hostChannelID), []string{"connection-on-solomachine"}, icqtypes.Version)
+	data, err := chain.Codec.Marshal(&channel)
+	require.NoError(t, err)
+
+	return solo.GenerateProof(&solomachine.SignBytes{
+		Sequence:    solo.Sequence,
+		Timestamp:   solo.Time,
+		Diversifier: solo.Diversifier,
+		Path:        []byte(solo.GetChannelStatePath(icqControllerPort, icqControllerChannel).String()),
+		Data:        data,
+	})
+}
+
+// openICQChannel opens an interchain query channel initiated by solo and
+// returns the channel of the host on chain.
+func openICQChannel(t *testing.T, chain *ibctesting.TestChain, solo *ibctesting.Solomachine) string {
+	t.Helper()
+
+	clientID := solo.CreateClient(chain)
+	connectionID := solo.ConnOpenInit(chain, clientID)
+	solo.ConnOpenAck(chain, clientID, connectionID)
+
+	signer := chain.SenderAccount.GetAddress().String()
+	proofInit := proveControllerChannel(t, chain, solo, channeltypes.INIT, "")
+	res, err := chain.SendMsgs(channeltypes.NewMsgChannelOpenTry(icqtypes.PortID, icqtypes.Version, channeltypes.UNORDERED, []string{connectionID}, icqControllerPort, icqControllerChannel, icqtypes.Version, proofInit, clienttypes.ZeroHeight(), signer))
+	require.NoError(t, err)
+	channelID := res.MsgResponses[0].GetCachedValue().(*channeltypes.MsgChannelOpenTryResponse).ChannelId
+
+	proofAck := proveControllerChannel(t, chain, solo, channeltypes.OPEN, channelID)
+	_, err = chain.SendMsgs(channeltypes.NewMsgChannelOpenConfirm(icqtypes.PortID, channelID, proofAck, clienttypes.ZeroHeight(), signer))
+	require.NoError(t, err)
+
+	return channelID
+}
+
+// sendQuery relays an interchain query packet of reqs from solo to the host
+// channel on chain and returns its acknowledgement.
+func sendQuery(t *testing.T, chain *ibctesting.TestChain, solo *ibctesting.Solomachine, channelID string, sequence uint64, reqs ...abci.RequestQuery) channeltypes.Acknowledgement {
+	t.Helper()
+
+	bz, err := icqtypes.SerializeCosmosQuery(reqs)
+	require.NoError(t, err)
+	data := icqtypes.InterchainQueryPacketData{Data: bz}
+
+	packet := channeltypes.NewPacket(
+		data.GetBytes(),
+		sequence,
+		icqControllerPort,
+		icqControllerChannel,
+		icqtypes.PortID,
+		channelID,
+		clienttypes.ZeroHeight(),
+		uint64(chain.GetContext().BlockTime().Add(time.Hour).UnixNano()),
+	)
+	res, err := chain.SendMsgs(channeltypes.NewMsgRecvPacket(packet, solo.GenerateCommitmentProof(packet), clienttypes.ZeroHeight(), chain.SenderAccount.GetAddress().String()))
+	require.NoError(t, err)
+
+	bz, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+	require.NoError(t, err)
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(bz, &ack))
+	return ack
+}
+
+func TestInterchainQueries(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	protonApp := chain.App.(*app.ProtonApp)
+
+	solo := ibctesting.NewSolomachine(t, chain.Codec, "solomachine", "testing", 1)
+	channelID := openICQChannel(t, chain, solo)
+
+	address := chain.SenderAccount.GetAddress()
+	balanceReq := &banktypes.QueryBalanceRequest{Address: address.String(), Denom: sdk.DefaultBondDenom}
+	balanceQuery := abci.RequestQuery{Path: "/cosmos.bank.v1beta1.Query/Balance", Data: protonApp.AppCodec().MustMarshal(balanceReq)}
+	supplyQuery := abci.RequestQuery{Path: "/cosmos.bank.v1beta1.Query/SupplyOf", Data: protonApp.AppCodec().MustMarshal(&banktypes.QuerySupplyOfRequest{Denom: sdk.DefaultBondDenom})}
+
+	// no query is allowed by default
+	ack := sendQuery(t, chain, solo, channelID, 1, balanceQuery)
+	require.False(t, ack.Success())
+
+	// governance allows the balance query
+	params := icqtypes.NewParams(balanceQuery.Path)
+	require.NoError(t, protonApp.ICQKeeper.SetParams(chain.GetContext(), params))
+	coordinator.CommitBlock(chain)
+
+	balance := protonApp.BankKeeper.GetBalance(chain.GetContext(), address, sdk.DefaultBondDenom)
+	ack = sendQuery(t, chain, solo, channelID, 2, balanceQuery)
+	require.True(t, ack.Success())
+
+	var result icqtypes.InterchainQueryPacketAck
+	require.NoError(t, icqtypes.ModuleCdc.UnmarshalJSON(ack.GetResult(), &result))
+	resps, err := icqtypes.DeserializeCosmosResponse(result.Data)
+	require.NoError(t, err)
+	require.Len(t, resps, 1)
+
+	var balanceRes banktypes.QueryBalanceResponse
+	protonApp.AppCodec().MustUnmarshal(resps[0].Value, &balanceRes)
+	require.Equal(t, balance, *balanceRes.Balance)
+
+	// a batch fails as a whole if any of its queries is not allowed
+	ack = sendQuery(t, chain, solo, channelID, 3, balanceQuery, supplyQuery)
+	require.False(t, ack.Success())
+
+	// queries of past heights and proofs cannot be served
+	ack = sendQuery(t, chain, solo, channelID, 4, abci.RequestQuery{Path: balanceQuery.Path, Data: balanceQuery.Data, Prove: true})
+	require.False(t, ack.Success())
+	ack = sendQuery(t, chain, solo, channelID, 5, abci.RequestQuery{Path: balanceQuery.Path, Data: balanceQuery.Data, Height: 1})
+	require.False(t, ack.Success())
+}
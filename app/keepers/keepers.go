@@ -14,6 +14,7 @@ import (
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
 	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -42,6 +43,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	packetforward "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/router"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/router/keeper"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/router/types"
 	icacontroller "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
 	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
@@ -60,6 +64,38 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
 	"github.com/spf13/cast"
+
+	circuitkeeper "github.com/fatal-fruit/proton/x/circuit/keeper"
+	circuittypes "github.com/fatal-fruit/proton/x/circuit/types"
+	clientmonitorkeeper "github.com/fatal-fruit/proton/x/clientmonitor/keeper"
+	clientmonitortypes "github.com/fatal-fruit/proton/x/clientmonitor/types"
+	feemarketkeeper "github.com/fatal-fruit/proton/x/feemarket/keeper"
+	feemarkettypes "github.com/fatal-fruit/proton/x/feemarket/types"
+	"github.com/fatal-fruit/proton/x/feepolicy"
+	feepolicykeeper "github.com/fatal-fruit/proton/x/feepolicy/keeper"
+	feepolicytypes "github.com/fatal-fruit/proton/x/feepolicy/types"
+	globalfeekeeper "github.com/fatal-fruit/proton/x/globalfee/keeper"
+	globalfeetypes "github.com/fatal-fruit/proton/x/globalfee/types"
+	"github.com/fatal-fruit/proton/x/ibchooks"
+	"github.com/fatal-fruit/proton/x/icaauth"
+	icaauthkeeper "github.com/fatal-fruit/proton/x/icaauth/keeper"
+	icaauthtypes "github.com/fatal-fruit/proton/x/icaauth/types"
+	"github.com/fatal-fruit/proton/x/icq"
+	icqkeeper "github.com/fatal-fruit/proton/x/icq/keeper"
+	icqtypes "github.com/fatal-fruit/proton/x/icq/types"
+	inflationkeeper "github.com/fatal-fruit/proton/x/inflation/keeper"
+	inflationtypes "github.com/fatal-fruit/proton/x/inflation/types"
+	"github.com/fatal-fruit/proton/x/nfttransfer"
+	nfttransferkeeper "github.com/fatal-fruit/proton/x/nfttransfer/keeper"
+	nfttransfertypes "github.com/fatal-fruit/proton/x/nfttransfer/types"
+	"github.com/fatal-fruit/proton/x/ratelimit"
+	ratelimitkeeper "github.com/fatal-fruit/proton/x/ratelimit/keeper"
+	ratelimittypes "github.com/fatal-fruit/proton/x/ratelimit/types"
+	refundkeeper "github.com/fatal-fruit/proton/x/refund/keeper"
+	refundtypes "github.com/fatal-fruit/proton/x/refund/types"
+	"github.com/fatal-fruit/proton/x/transferfilter"
+	transferfilterkeeper "github.com/fatal-fruit/proton/x/transferfilter/keeper"
+	transferfiltertypes "github.com/fatal-fruit/proton/x/transferfilter/types"
 )
 
 type AppKeepers struct {
@@ -91,6 +127,20 @@ type AppKeepers struct {
 	ICAControllerKeeper icacontrollerkeeper.Keeper
 	ICAHostKeeper       icahostkeeper.Keeper
 	TransferKeeper      ibctransferkeeper.Keeper
+	PacketForwardKeeper *packetforwardkeeper.Keeper
+
+	GlobalFeeKeeper      globalfeekeeper.Keeper
+	FeeMarketKeeper      feemarketkeeper.Keeper
+	CircuitKeeper        circuitkeeper.Keeper
+	ICAAuthKeeper        icaauthkeeper.Keeper
+	RateLimitKeeper      ratelimitkeeper.Keeper
+	ICQKeeper            icqkeeper.Keeper
+	NFTTransferKeeper    nfttransferkeeper.Keeper
+	FeePolicyKeeper      feepolicykeeper.Keeper
+	ClientMonitorKeeper  clientmonitorkeeper.Keeper
+	TransferFilterKeeper transferfilterkeeper.Keeper
+	InflationKeeper      inflationkeeper.Keeper
+	RefundKeeper         refundkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
@@ -98,6 +148,8 @@ type AppKeepers struct {
 	ScopedICAControllerKeeper capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
 	ScopedIBCFeeKeeper        capabilitykeeper.ScopedKeeper
+	ScopedICQKeeper           capabilitykeeper.ScopedKeeper
+	ScopedNFTTransferKeeper   capabilitykeeper.ScopedKeeper
 }
 
 func NewAppKeeper(
@@ -126,7 +178,7 @@ func NewAppKeeper(
 	)
 
 	// set the BaseApp's parameter store
-	appKeepers.ConsensusParamsKeeper = consensusparamkeeper.NewKeeper(appCodec, keys[upgradetypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	appKeepers.ConsensusParamsKeeper = consensusparamkeeper.NewKeeper(appCodec, keys[consensusparamtypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	bApp.SetParamStore(&appKeepers.ConsensusParamsKeeper)
 
 	// add capability keeper and ScopeToModule for ibc module
@@ -140,6 +192,9 @@ func NewAppKeeper(
 	scopedICAHostKeeper := appKeepers.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 	scopedICAControllerKeeper := appKeepers.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 	scopedTransferKeeper := appKeepers.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedIBCFeeKeeper := appKeepers.CapabilityKeeper.ScopeToModule(ibcfeetypes.ModuleName)
+	scopedICQKeeper := appKeepers.CapabilityKeeper.ScopeToModule(icqtypes.ModuleName)
+	scopedNFTTransferKeeper := appKeepers.CapabilityKeeper.ScopeToModule(nfttransfertypes.ModuleName)
 	appKeepers.CapabilityKeeper.Seal()
 
 	// add keepers
@@ -175,6 +230,12 @@ func NewAppKeeper(
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	appKeepers.InflationKeeper = inflationkeeper.NewKeeper(
+		appCodec,
+		keys[inflationtypes.StoreKey],
+		appKeepers.StakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 	appKeepers.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec,
 		keys[distrtypes.StoreKey],
@@ -271,8 +332,30 @@ func NewAppKeeper(
 		),
 	)
 
-	// RegisterUpgradeHandlers is used for registering any on-chain upgrades.
-	// app.RegisterUpgradeHandlers()
+	appKeepers.GlobalFeeKeeper = globalfeekeeper.NewKeeper(
+		appCodec,
+		keys[globalfeetypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.FeeMarketKeeper = feemarketkeeper.NewKeeper(
+		appCodec,
+		keys[feemarkettypes.StoreKey],
+		appKeepers.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.RefundKeeper = refundkeeper.NewKeeper(
+		appCodec,
+		keys[refundtypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.CircuitKeeper = circuitkeeper.NewKeeper(
+		appCodec,
+		keys[circuittypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 
 	appKeepers.NFTKeeper = nftkeeper.NewKeeper(
 		keys[nftkeeper.StoreKey],
@@ -299,18 +382,60 @@ func NewAppKeeper(
 		&appKeepers.IBCKeeper.PortKeeper, appKeepers.AccountKeeper, appKeepers.BankKeeper,
 	)
 
+	// Fee policy keeper attaching the governance funded relayer fees to the
+	// packets sent through it
+	appKeepers.FeePolicyKeeper = feepolicykeeper.NewKeeper(
+		appCodec,
+		keys[feepolicytypes.StoreKey],
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+		appKeepers.IBCFeeKeeper,
+		appKeepers.IBCFeeKeeper, // ICS4 Wrapper: fee IBC middleware
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// Packet forward middleware keeper, its transfer keeper is set once the
+	// transfer keeper exists
+	appKeepers.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
+		appCodec,
+		keys[packetforwardtypes.StoreKey],
+		appKeepers.GetSubspace(packetforwardtypes.ModuleName),
+		nil, // set with SetTransferKeeper below
+		appKeepers.IBCKeeper.ChannelKeeper,
+		appKeepers.DistrKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.FeePolicyKeeper, // ICS4 Wrapper: fee policy middleware
+	)
+
+	appKeepers.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		appCodec,
+		keys[ratelimittypes.StoreKey],
+		appKeepers.BankKeeper,
+		appKeepers.PacketForwardKeeper, // ICS4 Wrapper: packet forward middleware
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.TransferFilterKeeper = transferfilterkeeper.NewKeeper(
+		appCodec,
+		keys[transferfiltertypes.StoreKey],
+		appKeepers.RateLimitKeeper, // ICS4 Wrapper: rate limit middleware
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// Create Transfer Keepers
 	appKeepers.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
 		keys[ibctransfertypes.StoreKey],
 		appKeepers.GetSubspace(ibctransfertypes.ModuleName),
-		appKeepers.IBCFeeKeeper, // ISC4 Wrapper: fee IBC middleware
+		appKeepers.TransferFilterKeeper, // ICS4 Wrapper: transfer filter middleware
 		appKeepers.IBCKeeper.ChannelKeeper,
 		&appKeepers.IBCKeeper.PortKeeper,
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		scopedTransferKeeper,
 	)
+	appKeepers.PacketForwardKeeper.SetTransferKeeper(appKeepers.TransferKeeper)
 
 	appKeepers.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec,
@@ -321,37 +446,85 @@ func NewAppKeeper(
 		&appKeepers.IBCKeeper.PortKeeper,
 		appKeepers.AccountKeeper,
 		scopedICAHostKeeper,
-		bApp.MsgServiceRouter(),
+		circuitkeeper.NewMsgRouter(appKeepers.CircuitKeeper, appKeepers.GroupKeeper, bApp.MsgServiceRouter()), // ICA host txs skip the ante handler
 	)
 	appKeepers.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
 		appCodec,
 		keys[icacontrollertypes.StoreKey],
 		appKeepers.GetSubspace(icacontrollertypes.SubModuleName),
-		appKeepers.IBCFeeKeeper, // use ics29 fee as ics4Wrapper in middleware stack
+		appKeepers.FeePolicyKeeper, // use the fee policy, then ics29 fee as ics4Wrapper in middleware stack
 		appKeepers.IBCKeeper.ChannelKeeper,
 		&appKeepers.IBCKeeper.PortKeeper,
 		scopedICAControllerKeeper,
 		bApp.MsgServiceRouter(),
 	)
+	appKeepers.ICAAuthKeeper = icaauthkeeper.NewKeeper(
+		appCodec,
+		keys[icaauthtypes.StoreKey],
+		appKeepers.ICAControllerKeeper,
+	)
+
+	appKeepers.ICQKeeper = icqkeeper.NewKeeper(
+		appCodec,
+		keys[icqtypes.StoreKey],
+		&appKeepers.IBCKeeper.PortKeeper,
+		scopedICQKeeper,
+		bApp.GRPCQueryRouter(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.NFTTransferKeeper = nfttransferkeeper.NewKeeper(
+		appCodec,
+		keys[nfttransfertypes.StoreKey],
+		appKeepers.IBCFeeKeeper, // ICS4 Wrapper: fee IBC middleware
+		&appKeepers.IBCKeeper.PortKeeper,
+		scopedNFTTransferKeeper,
+		appKeepers.NFTKeeper,
+	)
+
+	appKeepers.ClientMonitorKeeper = clientmonitorkeeper.NewKeeper(
+		appCodec,
+		keys[clientmonitortypes.StoreKey],
+		appKeepers.IBCKeeper.ClientKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 
 	appKeepers.GovKeeper.SetLegacyRouter(govRouter)
 
 	// Set IBC Keepers
 
 	// Create Transfer Stack
+	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
+	// channel.RecvPacket -> feePolicy.OnRecvPacket -> fee.OnRecvPacket -> transferFilter.OnRecvPacket -> rateLimit.OnRecvPacket -> packetForward.OnRecvPacket -> ibcHooks.OnRecvPacket -> transfer.OnRecvPacket
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(appKeepers.TransferKeeper)
+	transferStack = ibchooks.NewIBCMiddleware(
+		transferStack,
+		appCodec,
+		circuitkeeper.NewMsgRouter(appKeepers.CircuitKeeper, appKeepers.GroupKeeper, bApp.MsgServiceRouter()), // hook msgs skip the ante handler
+	)
+	transferStack = packetforward.NewIBCMiddleware(
+		transferStack,
+		appKeepers.PacketForwardKeeper,
+		0, // retries on timeout
+		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
+		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
+	)
+	transferStack = ratelimit.NewIBCMiddleware(transferStack, appKeepers.RateLimitKeeper)
+	transferStack = transferfilter.NewIBCMiddleware(transferStack, appKeepers.TransferFilterKeeper)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, appKeepers.IBCFeeKeeper)
+	transferStack = feepolicy.NewIBCMiddleware(transferStack, appKeepers.FeePolicyKeeper)
 
 	// Create Interchain Accounts Stack
 	// SendPacket, since it is originating from the application to core IBC:
-	// icaAuthModuleKeeper.SendTx -> icaController.SendPacket -> fee.SendPacket -> channel.SendPacket
+	// icaAuthModuleKeeper.SendTx -> icaController.SendPacket -> feePolicy.SendPacket -> fee.SendPacket -> channel.SendPacket
 	var icaControllerStack porttypes.IBCModule
 
-	// initialize ICA module with empty mauth module as the authentication module on the controller side
-	var emptyMauth porttypes.IBCModule
-	icaControllerStack = icacontroller.NewIBCMiddleware(emptyMauth, appKeepers.ICAControllerKeeper)
+	// initialize ICA module with x/icaauth as the authentication module on the controller side
+	icaControllerStack = icaauth.NewIBCModule(appKeepers.ICAAuthKeeper)
+	icaControllerStack = icacontroller.NewIBCMiddleware(icaControllerStack, appKeepers.ICAControllerKeeper)
 	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, appKeepers.IBCFeeKeeper)
+	icaControllerStack = feepolicy.NewIBCMiddleware(icaControllerStack, appKeepers.FeePolicyKeeper)
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
 	// channel.RecvPacket -> fee.OnRecvPacket -> icaHost.OnRecvPacket
@@ -359,15 +532,39 @@ func NewAppKeeper(
 	icaHostStack = icahost.NewIBCModule(appKeepers.ICAHostKeeper)
 	icaHostStack = ibcfee.NewIBCMiddleware(icaHostStack, appKeepers.IBCFeeKeeper)
 
+	// Create Interchain Query Stack
+	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
+	// channel.RecvPacket -> fee.OnRecvPacket -> icq.OnRecvPacket
+	var icqStack porttypes.IBCModule
+	icqStack = icq.NewIBCModule(appKeepers.ICQKeeper)
+	icqStack = ibcfee.NewIBCMiddleware(icqStack, appKeepers.IBCFeeKeeper)
+
+	// Create NFT Transfer Stack
+	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
+	// channel.RecvPacket -> fee.OnRecvPacket -> nftTransfer.OnRecvPacket
+	var nftTransferStack porttypes.IBCModule
+	nftTransferStack = nfttransfer.NewIBCModule(appKeepers.NFTTransferKeeper)
+	nftTransferStack = ibcfee.NewIBCMiddleware(nftTransferStack, appKeepers.IBCFeeKeeper)
+
 	// Add host, controller & ica auth modules to IBC router
 	ibcRouter := porttypes.NewRouter().
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
-		AddRoute(icahosttypes.SubModuleName, icaHostStack)
+		AddRoute(icahosttypes.SubModuleName, icaHostStack).
+		AddRoute(icqtypes.ModuleName, icqStack).
+		AddRoute(nfttransfertypes.ModuleName, nftTransferStack)
 
 	// Seal the IBC Router
 	appKeepers.IBCKeeper.SetRouter(ibcRouter)
 
+	appKeepers.ScopedIBCKeeper = scopedIBCKeeper
+	appKeepers.ScopedICAHostKeeper = scopedICAHostKeeper
+	appKeepers.ScopedICAControllerKeeper = scopedICAControllerKeeper
+	appKeepers.ScopedTransferKeeper = scopedTransferKeeper
+	appKeepers.ScopedIBCFeeKeeper = scopedIBCFeeKeeper
+	appKeepers.ScopedICQKeeper = scopedICQKeeper
+	appKeepers.ScopedNFTTransferKeeper = scopedNFTTransferKeeper
+
 	return appKeepers
 }
 
@@ -393,6 +590,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibcexported.ModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
+	paramsKeeper.Subspace(packetforwardtypes.ModuleName)
 
 	return paramsKeeper
 }
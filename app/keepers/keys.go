@@ -20,11 +20,25 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/router/types"
 	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
 	icahosttypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/types"
 	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	circuittypes "github.com/fatal-fruit/proton/x/circuit/types"
+	clientmonitortypes "github.com/fatal-fruit/proton/x/clientmonitor/types"
+	feemarkettypes "github.com/fatal-fruit/proton/x/feemarket/types"
+	feepolicytypes "github.com/fatal-fruit/proton/x/feepolicy/types"
+	globalfeetypes "github.com/fatal-fruit/proton/x/globalfee/types"
+	icaauthtypes "github.com/fatal-fruit/proton/x/icaauth/types"
+	icqtypes "github.com/fatal-fruit/proton/x/icq/types"
+	inflationtypes "github.com/fatal-fruit/proton/x/inflation/types"
+	nfttransfertypes "github.com/fatal-fruit/proton/x/nfttransfer/types"
+	ratelimittypes "github.com/fatal-fruit/proton/x/ratelimit/types"
+	refundtypes "github.com/fatal-fruit/proton/x/refund/types"
+	transferfiltertypes "github.com/fatal-fruit/proton/x/transferfilter/types"
 )
 
 func (appKeepers *AppKeepers) GenerateKeys() {
@@ -37,8 +51,12 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 		govtypes.StoreKey, paramstypes.StoreKey, consensusparamtypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
 		authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey,
-		ibcfeetypes.StoreKey,
+		ibcfeetypes.StoreKey, packetforwardtypes.StoreKey,
 		icahosttypes.StoreKey, icacontrollertypes.StoreKey,
+		globalfeetypes.StoreKey, feemarkettypes.StoreKey, circuittypes.StoreKey, icaauthtypes.StoreKey,
+		ratelimittypes.StoreKey, icqtypes.StoreKey, nfttransfertypes.StoreKey, feepolicytypes.StoreKey,
+		clientmonitortypes.StoreKey, transferfiltertypes.StoreKey, inflationtypes.StoreKey,
+		refundtypes.StoreKey,
 	)
 
 	// Define transient store keys
@@ -0,0 +1,108 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	localhost "github.com/cosmos/ibc-go/v7/modules/light-clients/09-localhost"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+)
+
+func TestSolomachineTransfer(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	protonApp := chain.App.(*app.ProtonApp)
+
+	solo := ibctesting.NewSolomachine(t, chain.Codec, "solomachine", "testing", 1)
+	clientID := solo.CreateClient(chain)
+	require.Equal(t, ibcexported.Active, protonApp.IBCKeeper.ClientKeeper.GetClientStatus(chain.GetContext(), solo.ClientState(), clientID))
+
+	connectionID := solo.ConnOpenInit(chain, clientID)
+	solo.ConnOpenAck(chain, clientID, connectionID)
+	channelID := solo.ChanOpenInit(chain, connectionID)
+	solo.ChanOpenAck(chain, channelID)
+
+	// tokens sent to the solo machine are escrowed until acknowledged
+	sender := chain.SenderAccount.GetAddress()
+	balance := protonApp.BankKeeper.GetBalance(chain.GetContext(), sender, sdk.DefaultBondDenom)
+	packet := solo.SendTransfer(chain, transfertypes.PortID, channelID)
+	solo.AcknowledgePacket(chain, packet)
+	require.Equal(t, balance.SubAmount(sdk.NewInt(100)), protonApp.BankKeeper.GetBalance(chain.GetContext(), sender, sdk.DefaultBondDenom))
+
+	// tokens of the solo machine are received as vouchers
+	data := transfertypes.NewFungibleTokenPacketData("solo", "100", "solomachine", sender.String(), "")
+	packet = channeltypes.NewPacket(
+		data.GetBytes(),
+		1,
+		transfertypes.PortID,
+		"channel-on-solomachine",
+		transfertypes.PortID,
+		channelID,
+		clienttypes.ZeroHeight(),
+		uint64(chain.GetContext().BlockTime().Add(time.Hour).UnixNano()),
+	)
+	solo.RecvPacket(chain, packet)
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, channelID, "solo")).IBCDenom()
+	require.Equal(t, sdk.NewInt(100), protonApp.BankKeeper.GetBalance(chain.GetContext(), sender, voucherDenom).Amount)
+}
+
+func TestLocalhostTransfer(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	protonApp := chain.App.(*app.ProtonApp)
+	signer := chain.SenderAccount.GetAddress().String()
+
+	sendMsg := func(msg sdk.Msg) *sdk.Result {
+		res, err := chain.SendMsgs(msg)
+		require.NoError(t, err)
+		return res
+	}
+	proofHeight := func() clienttypes.Height {
+		return clienttypes.GetSelfHeight(chain.GetContext())
+	}
+
+	// both channel ends are opened on proton over the sentinel connection
+	connectionHops := []string{ibcexported.LocalhostConnectionID}
+	res := sendMsg(channeltypes.NewMsgChannelOpenInit(transfertypes.PortID, transfertypes.Version, channeltypes.UNORDERED, connectionHops, transfertypes.PortID, signer))
+	channelA := res.MsgResponses[0].GetCachedValue().(*channeltypes.MsgChannelOpenInitResponse).ChannelId
+
+	res = sendMsg(channeltypes.NewMsgChannelOpenTry(transfertypes.PortID, transfertypes.Version, channeltypes.UNORDERED, connectionHops, transfertypes.PortID, channelA, transfertypes.Version, localhost.SentinelProof, proofHeight(), signer))
+	channelB := res.MsgResponses[0].GetCachedValue().(*channeltypes.MsgChannelOpenTryResponse).ChannelId
+
+	sendMsg(channeltypes.NewMsgChannelOpenAck(transfertypes.PortID, channelA, channelB, transfertypes.Version, localhost.SentinelProof, proofHeight(), signer))
+	sendMsg(channeltypes.NewMsgChannelOpenConfirm(transfertypes.PortID, channelB, localhost.SentinelProof, proofHeight(), signer))
+
+	channel, found := protonApp.IBCKeeper.ChannelKeeper.GetChannel(chain.GetContext(), transfertypes.PortID, channelB)
+	require.True(t, found)
+	require.Equal(t, channeltypes.OPEN, channel.State)
+
+	// transfer to another account of proton and relay the packet to itself
+	sender := chain.SenderAccount.GetAddress()
+	receiver := chain.SenderAccounts[1].SenderAccount.GetAddress()
+	balance := protonApp.BankKeeper.GetBalance(chain.GetContext(), sender, sdk.DefaultBondDenom)
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+
+	res = sendMsg(transfertypes.NewMsgTransfer(transfertypes.PortID, channelA, amount, sender.String(), receiver.String(), clienttypes.ZeroHeight(), uint64(chain.GetContext().BlockTime().Add(time.Hour).UnixNano()), ""))
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(t, err)
+
+	res = sendMsg(channeltypes.NewMsgRecvPacket(packet, localhost.SentinelProof, proofHeight(), signer))
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	sendMsg(channeltypes.NewMsgAcknowledgement(packet, ack, localhost.SentinelProof, proofHeight(), signer))
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, channelB, sdk.DefaultBondDenom)).IBCDenom()
+	require.Equal(t, amount.Amount, protonApp.BankKeeper.GetBalance(chain.GetContext(), receiver, voucherDenom).Amount)
+	require.Equal(t, balance.Sub(amount), protonApp.BankKeeper.GetBalance(chain.GetContext(), sender, sdk.DefaultBondDenom))
+
+	require.Empty(t, protonApp.IBCKeeper.ChannelKeeper.GetPacketCommitment(chain.GetContext(), transfertypes.PortID, channelA, packet.Sequence))
+}
@@ -44,6 +44,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	packetforward "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/router"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/router/types"
 	ica "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts"
 	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
 	ibcfee "github.com/cosmos/ibc-go/v7/modules/apps/29-fee"
@@ -53,7 +55,33 @@ import (
 	ibc "github.com/cosmos/ibc-go/v7/modules/core"
 	ibcclientclient "github.com/cosmos/ibc-go/v7/modules/core/02-client/client"
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	solomachine "github.com/cosmos/ibc-go/v7/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+
+	"github.com/fatal-fruit/proton/x/circuit"
+	circuittypes "github.com/fatal-fruit/proton/x/circuit/types"
+	"github.com/fatal-fruit/proton/x/clientmonitor"
+	clientmonitortypes "github.com/fatal-fruit/proton/x/clientmonitor/types"
+	"github.com/fatal-fruit/proton/x/feemarket"
+	feemarkettypes "github.com/fatal-fruit/proton/x/feemarket/types"
+	"github.com/fatal-fruit/proton/x/feepolicy"
+	feepolicytypes "github.com/fatal-fruit/proton/x/feepolicy/types"
+	"github.com/fatal-fruit/proton/x/globalfee"
+	globalfeetypes "github.com/fatal-fruit/proton/x/globalfee/types"
+	"github.com/fatal-fruit/proton/x/icaauth"
+	icaauthtypes "github.com/fatal-fruit/proton/x/icaauth/types"
+	"github.com/fatal-fruit/proton/x/icq"
+	icqtypes "github.com/fatal-fruit/proton/x/icq/types"
+	"github.com/fatal-fruit/proton/x/inflation"
+	inflationtypes "github.com/fatal-fruit/proton/x/inflation/types"
+	"github.com/fatal-fruit/proton/x/nfttransfer"
+	nfttransfertypes "github.com/fatal-fruit/proton/x/nfttransfer/types"
+	"github.com/fatal-fruit/proton/x/ratelimit"
+	ratelimittypes "github.com/fatal-fruit/proton/x/ratelimit/types"
+	"github.com/fatal-fruit/proton/x/refund"
+	refundtypes "github.com/fatal-fruit/proton/x/refund/types"
+	"github.com/fatal-fruit/proton/x/transferfilter"
+	transferfiltertypes "github.com/fatal-fruit/proton/x/transferfilter/types"
 )
 
 var (
@@ -90,9 +118,23 @@ var (
 		consensus.AppModuleBasic{},
 		ibc.AppModuleBasic{},
 		ibctm.AppModuleBasic{},
+		solomachine.AppModuleBasic{},
 		transfer.AppModuleBasic{},
 		ica.AppModuleBasic{},
 		ibcfee.AppModuleBasic{},
+		packetforward.AppModuleBasic{},
+		globalfee.AppModuleBasic{},
+		feemarket.AppModuleBasic{},
+		circuit.AppModuleBasic{},
+		icaauth.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
+		icq.AppModuleBasic{},
+		nfttransfer.AppModuleBasic{},
+		feepolicy.AppModuleBasic{},
+		clientmonitor.AppModuleBasic{},
+		transferfilter.AppModuleBasic{},
+		inflation.AppModuleBasic{},
+		refund.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -107,6 +149,8 @@ var (
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		ibcfeetypes.ModuleName:         nil,
 		icatypes.ModuleName:            nil,
+		feemarkettypes.ModuleName:      {authtypes.Burner},
+		feepolicytypes.ModuleName:      nil,
 	}
 )
 
@@ -130,7 +174,7 @@ func appModules(
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper, false),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, &app.GovKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(govtypes.ModuleName)),
-		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil, app.GetSubspace(minttypes.ModuleName)),
+		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, app.InflationKeeper.InflationCalculationFn, app.GetSubspace(minttypes.ModuleName)),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(slashingtypes.ModuleName)),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(distrtypes.ModuleName)),
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName)),
@@ -144,7 +188,20 @@ func appModules(
 		ibc.NewAppModule(app.IBCKeeper),
 		transfer.NewAppModule(app.TransferKeeper),
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
+		packetforward.NewAppModule(app.PacketForwardKeeper),
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
+		globalfee.NewAppModule(appCodec, app.GlobalFeeKeeper),
+		feemarket.NewAppModule(appCodec, app.FeeMarketKeeper),
+		circuit.NewAppModule(appCodec, app.CircuitKeeper),
+		icaauth.NewAppModule(appCodec, app.ICAAuthKeeper),
+		ratelimit.NewAppModule(appCodec, app.RateLimitKeeper),
+		icq.NewAppModule(appCodec, app.ICQKeeper),
+		nfttransfer.NewAppModule(appCodec, app.NFTTransferKeeper),
+		feepolicy.NewAppModule(appCodec, app.FeePolicyKeeper),
+		clientmonitor.NewAppModule(appCodec, app.ClientMonitorKeeper),
+		transferfilter.NewAppModule(appCodec, app.TransferFilterKeeper),
+		inflation.NewAppModule(appCodec, app.InflationKeeper),
+		refund.NewAppModule(appCodec, app.RefundKeeper),
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)), // always be last to make sure that it checks for all invariants and not only part of them
 	}
 }
@@ -160,6 +217,19 @@ func orderBeginBlockers() []string {
 		ibcexported.ModuleName,
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
+		packetforwardtypes.ModuleName,
+		globalfeetypes.ModuleName,
+		feemarkettypes.ModuleName,
+		circuittypes.ModuleName,
+		icaauthtypes.ModuleName,
+		ratelimittypes.ModuleName,
+		icqtypes.ModuleName,
+		nfttransfertypes.ModuleName,
+		feepolicytypes.ModuleName,
+		clientmonitortypes.ModuleName,
+		transferfiltertypes.ModuleName,
+		inflationtypes.ModuleName,
+		refundtypes.ModuleName,
 	}
 }
 
@@ -188,6 +258,19 @@ func orderEndBlockers() []string {
 		ibcexported.ModuleName,
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
+		packetforwardtypes.ModuleName,
+		globalfeetypes.ModuleName,
+		feemarkettypes.ModuleName,
+		circuittypes.ModuleName,
+		icaauthtypes.ModuleName,
+		ratelimittypes.ModuleName,
+		icqtypes.ModuleName,
+		nfttransfertypes.ModuleName,
+		feepolicytypes.ModuleName,
+		clientmonitortypes.ModuleName,
+		transferfiltertypes.ModuleName,
+		inflationtypes.ModuleName,
+		refundtypes.ModuleName,
 	}
 }
 
@@ -216,5 +299,18 @@ func orderInitBlockers() []string {
 		ibcexported.ModuleName,
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
+		packetforwardtypes.ModuleName,
+		globalfeetypes.ModuleName,
+		feemarkettypes.ModuleName,
+		circuittypes.ModuleName,
+		icaauthtypes.ModuleName,
+		ratelimittypes.ModuleName,
+		icqtypes.ModuleName,
+		nfttransfertypes.ModuleName,
+		feepolicytypes.ModuleName,
+		clientmonitortypes.ModuleName,
+		transferfiltertypes.ModuleName,
+		inflationtypes.ModuleName,
+		refundtypes.ModuleName,
 	}
 }
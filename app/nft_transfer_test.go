@@ -0,0 +1,131 @@
+package app_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/gogoproto/proto"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	nfttransferkeeper "github.com/fatal-fruit/proton/x/nfttransfer/keeper"
+	nfttransfertypes "github.com/fatal-fruit/proton/x/nfttransfer/types"
+)
+
+func newNFTTransferPath(chainA, chainB *ibctesting.TestChain, version string) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = nfttransfertypes.PortID
+	path.EndpointB.ChannelConfig.PortID = nfttransfertypes.PortID
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+
+	return path
+}
+
+func TestNFTTransfer(t *testing.T) {
+	feeVersion := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+		FeeVersion: ibcfeetypes.Version,
+		AppVersion: nfttransfertypes.Version,
+	}))
+
+	testCases := []struct {
+		name    string
+		version string
+	}{
+		{"plain nft transfer channel", nfttransfertypes.Version},
+		{"fee enabled nft transfer channel", feeVersion},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			coordinator := ibctesting.NewCoordinator(t, 2)
+			path := newNFTTransferPath(coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2)), tc.version)
+			coordinator.Setup(path)
+
+			chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+			protonAppA := chainA.App.(*app.ProtonApp)
+			protonAppB := chainB.App.(*app.ProtonApp)
+			sender := chainA.SenderAccount.GetAddress()
+			receiver := chainB.SenderAccount.GetAddress()
+
+			transfer := func(endpoint *ibctesting.Endpoint, classID string, tokenIDs []string, sender sdk.AccAddress, receiver string, timeoutHeight clienttypes.Height) channeltypes.Packet {
+				msg := nfttransfertypes.NewMsgTransfer(endpoint.ChannelConfig.PortID, endpoint.ChannelID, classID, tokenIDs, sender.String(), receiver, timeoutHeight, 0, "")
+				res, err := endpoint.Chain.SendMsgs(msg)
+				require.NoError(t, err)
+				packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+				require.NoError(t, err)
+				return packet
+			}
+
+			// the class and its NFTs are created on chain A
+			ctxA := chainA.GetContext()
+			require.NoError(t, protonAppA.NFTKeeper.SaveClass(ctxA, nft.Class{Id: "kitties", Uri: "https://kitties.example"}))
+			for _, id := range []string{"kitty1", "kitty2", "kitty3"} {
+				require.NoError(t, protonAppA.NFTKeeper.Mint(ctxA, nft.NFT{ClassId: "kitties", Id: id, Uri: "https://kitties.example/" + id}, sender))
+			}
+			coordinator.CommitBlock(chainA)
+
+			// chain A escrows the NFTs and chain B mints them as vouchers
+			packet := transfer(path.EndpointA, "kitties", []string{"kitty1", "kitty2"}, sender, receiver.String(), clienttypes.NewHeight(1, 110))
+			require.NoError(t, path.RelayPacket(packet))
+
+			escrowA := nfttransfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			require.Equal(t, escrowA, protonAppA.NFTKeeper.GetOwner(chainA.GetContext(), "kitties", "kitty1"))
+
+			trace := nfttransfertypes.ParseClassTrace(path.EndpointB.ChannelConfig.PortID + "/" + path.EndpointB.ChannelID + "/kitties")
+			voucherClassID := trace.IBCClassID()
+			ctxB := chainB.GetContext()
+			class, found := protonAppB.NFTKeeper.GetClass(ctxB, voucherClassID)
+			require.True(t, found)
+			require.Equal(t, "https://kitties.example", class.Uri)
+			var classData proto.Message
+			require.NoError(t, protonAppB.InterfaceRegistry().UnpackAny(class.Data, &classData))
+			require.Equal(t, &trace, classData)
+
+			voucher, found := protonAppB.NFTKeeper.GetNFT(ctxB, voucherClassID, "kitty1")
+			require.True(t, found)
+			require.Equal(t, "https://kitties.example/kitty1", voucher.Uri)
+			require.Equal(t, receiver, protonAppB.NFTKeeper.GetOwner(ctxB, voucherClassID, "kitty2"))
+
+			res, err := protonAppB.NFTTransferKeeper.ClassTrace(ctxB, &nfttransfertypes.QueryClassTraceRequest{Hash: voucherClassID})
+			require.NoError(t, err)
+			require.Equal(t, trace, res.ClassTrace)
+
+			// a voucher returning to chain A is burned and released from escrow
+			packet = transfer(path.EndpointB, voucherClassID, []string{"kitty1"}, receiver, sender.String(), clienttypes.NewHeight(1, 110))
+			require.NoError(t, path.RelayPacket(packet))
+			require.False(t, protonAppB.NFTKeeper.HasNFT(chainB.GetContext(), voucherClassID, "kitty1"))
+			require.Equal(t, sender, protonAppA.NFTKeeper.GetOwner(chainA.GetContext(), "kitties", "kitty1"))
+
+			// a timed out voucher transfer mints the burned voucher again
+			packet = transfer(path.EndpointB, voucherClassID, []string{"kitty2"}, receiver, sender.String(), clienttypes.GetSelfHeight(chainA.GetContext()).Increment().(clienttypes.Height))
+			require.False(t, protonAppB.NFTKeeper.HasNFT(chainB.GetContext(), voucherClassID, "kitty2"))
+			coordinator.CommitNBlocks(chainA, 2)
+			require.NoError(t, path.EndpointB.UpdateClient())
+			require.NoError(t, path.EndpointB.TimeoutPacket(packet))
+			voucher, found = protonAppB.NFTKeeper.GetNFT(chainB.GetContext(), voucherClassID, "kitty2")
+			require.True(t, found)
+			require.Equal(t, "https://kitties.example/kitty2", voucher.Uri)
+			require.Equal(t, receiver, protonAppB.NFTKeeper.GetOwner(chainB.GetContext(), voucherClassID, "kitty2"))
+
+			// chain B fails to receive an NFT for an invalid receiver and chain A
+			// releases it back to the sender
+			packet = transfer(path.EndpointA, "kitties", []string{"kitty3"}, sender, "not-an-address", clienttypes.NewHeight(1, 110))
+			require.Equal(t, escrowA, protonAppA.NFTKeeper.GetOwner(chainA.GetContext(), "kitties", "kitty3"))
+			require.NoError(t, path.RelayPacket(packet))
+			require.Equal(t, sender, protonAppA.NFTKeeper.GetOwner(chainA.GetContext(), "kitties", "kitty3"))
+
+			// only the owner may send NFTs
+			msg := nfttransfertypes.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucherClassID, []string{"kitty2"}, chainB.SenderAccounts[1].SenderAccount.GetAddress().String(), sender.String(), clienttypes.NewHeight(1, 110), 0, "")
+			_, err = nfttransferkeeper.NewMsgServerImpl(protonAppB.NFTTransferKeeper).Transfer(sdk.WrapSDKContext(chainB.GetContext()), msg)
+			require.Error(t, err)
+		})
+	}
+}
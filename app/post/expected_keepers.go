@@ -0,0 +1,24 @@
+package post
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	refundtypes "github.com/fatal-fruit/proton/x/refund/types"
+)
+
+// BankKeeper defines the expected bank keeper used to refund fees.
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// FeeMarketKeeper defines the expected x/feemarket keeper used to leave the
+// burned base fee out of refunds.
+type FeeMarketKeeper interface {
+	BaseFeeAmount(ctx sdk.Context, gas uint64) sdk.Coins
+}
+
+// RefundKeeper defines the expected x/refund keeper holding the share of
+// unused gas fees that is refunded.
+type RefundKeeper interface {
+	GetParams(ctx sdk.Context) refundtypes.Params
+}
@@ -0,0 +1,34 @@
+package post
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HandlerOptions are the options required for constructing the proton PostHandler.
+type HandlerOptions struct {
+	BankKeeper      BankKeeper
+	FeeMarketKeeper FeeMarketKeeper
+	RefundKeeper    RefundKeeper
+}
+
+// NewPostHandler returns a PostHandler that refunds the governance-set share
+// of the fees paid for unused gas.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
+	if options.BankKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for post builder")
+	}
+	if options.FeeMarketKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "feemarket keeper is required for post builder")
+	}
+	if options.RefundKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "refund keeper is required for post builder")
+	}
+
+	postDecorators := []sdk.PostDecorator{
+		NewRefundDecorator(options.BankKeeper, options.FeeMarketKeeper, options.RefundKeeper),
+	}
+
+	return sdk.ChainPostDecorators(postDecorators...), nil
+}
@@ -0,0 +1,95 @@
+package post
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	EventTypeRefundFee = "refund_fee"
+
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyGasUsed   = "gas_used"
+)
+
+// RefundDecorator refunds the share of the fee paid for unused gas from the
+// fee collector. The base fee burned by x/feemarket is not refundable. When
+// the fee was paid through a fee grant, the refund goes to the granter since
+// it is the account that was charged.
+type RefundDecorator struct {
+	bankKeeper      BankKeeper
+	feeMarketKeeper FeeMarketKeeper
+	refundKeeper    RefundKeeper
+}
+
+func NewRefundDecorator(bk BankKeeper, fmk FeeMarketKeeper, rk RefundKeeper) RefundDecorator {
+	return RefundDecorator{
+		bankKeeper:      bk,
+		feeMarketKeeper: fmk,
+		refundKeeper:    rk,
+	}
+}
+
+func (rd RefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	// fees are only charged for real once the tx is delivered
+	if !success || ctx.IsCheckTx() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	refund := rd.RefundAmount(ctx, feeTx.GetFee(), feeTx.GetGas(), ctx.GasMeter().GasConsumed())
+	if refund.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	recipient := feeTx.FeePayer()
+	if granter := feeTx.FeeGranter(); granter != nil {
+		recipient = granter
+	}
+
+	if err := rd.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipient, refund); err != nil {
+		return ctx, errorsmod.Wrapf(err, "failed to refund fee to %s", recipient)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeRefundFee,
+			sdk.NewAttribute(AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, refund.String()),
+			sdk.NewAttribute(AttributeKeyGasUsed, sdk.NewIntFromUint64(ctx.GasMeter().GasConsumed()).String()),
+		),
+	)
+
+	return next(ctx, tx, simulate, success)
+}
+
+// RefundAmount returns the refund owed for a tx which paid fee for gasLimit
+// and consumed gasUsed.
+func (rd RefundDecorator) RefundAmount(ctx sdk.Context, fee sdk.Coins, gasLimit, gasUsed uint64) sdk.Coins {
+	if gasLimit == 0 || gasUsed >= gasLimit {
+		return sdk.NewCoins()
+	}
+
+	// the base fee has already been burned
+	fee, hasNeg := fee.SafeSub(rd.feeMarketKeeper.BaseFeeAmount(ctx, gasLimit)...)
+	if hasNeg || fee.IsZero() {
+		return sdk.NewCoins()
+	}
+
+	params := rd.refundKeeper.GetParams(ctx)
+	if !params.RefundShare.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	unused := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasLimit - gasUsed))
+	ratio := params.RefundShare.Mul(unused).QuoInt(sdk.NewIntFromUint64(gasLimit))
+
+	refund, _ := sdk.NewDecCoinsFromCoins(fee...).MulDecTruncate(ratio).TruncateDecimal()
+	return refund
+}
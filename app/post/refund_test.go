@@ -0,0 +1,94 @@
+package post_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/app/post"
+	feemarkettypes "github.com/fatal-fruit/proton/x/feemarket/types"
+	refundtypes "github.com/fatal-fruit/proton/x/refund/types"
+)
+
+func TestRefundDecorator(t *testing.T) {
+	const (
+		gasLimit = 200_000
+		gasUsed  = 100_000
+	)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1_000))
+
+	testCases := []struct {
+		name        string
+		refundShare sdk.Dec
+		baseFee     sdk.Dec
+		withGranter bool
+		success     bool
+		expRefund   sdk.Coins
+	}{
+		{"no refund by default", sdk.ZeroDec(), sdk.ZeroDec(), false, true, sdk.NewCoins()},
+		{"half of the unused gas fee is refunded", sdk.NewDecWithPrec(5, 1), sdk.ZeroDec(), false, true, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 250))},
+		{"all of the unused gas fee is refunded", sdk.OneDec(), sdk.ZeroDec(), false, true, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 500))},
+		{"burned base fee is not refunded", sdk.OneDec(), sdk.NewDecWithPrec(1, 3), false, true, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 400))},
+		{"refund goes to the fee granter", sdk.OneDec(), sdk.ZeroDec(), true, true, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 500))},
+		{"failed txs are not refunded", sdk.OneDec(), sdk.ZeroDec(), false, false, sdk.NewCoins()},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			protonApp := app.Setup(t)
+			ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+			require.NoError(t, protonApp.BankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+			require.NoError(t, banktestutil.FundModuleAccount(protonApp.BankKeeper, ctx, authtypes.FeeCollectorName, fee))
+
+			require.NoError(t, protonApp.RefundKeeper.SetParams(ctx, refundtypes.NewParams(tc.refundShare)))
+
+			feeMarketParams := feemarkettypes.DefaultParams()
+			feeMarketParams.FeeDenom = app.BondDenom
+			require.NoError(t, protonApp.FeeMarketKeeper.SetParams(ctx, feeMarketParams))
+			protonApp.FeeMarketKeeper.SetBaseFee(ctx, tc.baseFee)
+
+			payer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			granter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+			txBuilder := protonApp.TxConfig().NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(payer, granter, fee)))
+			txBuilder.SetFeeAmount(fee)
+			txBuilder.SetGasLimit(gasLimit)
+			if tc.withGranter {
+				txBuilder.SetFeeGranter(granter)
+			}
+
+			gasMeter := sdk.NewGasMeter(gasLimit)
+			gasMeter.ConsumeGas(gasUsed, "test")
+			ctx = ctx.WithGasMeter(gasMeter)
+
+			postHandler, err := post.NewPostHandler(post.HandlerOptions{
+				BankKeeper:      protonApp.BankKeeper,
+				FeeMarketKeeper: protonApp.FeeMarketKeeper,
+				RefundKeeper:    protonApp.RefundKeeper,
+			})
+			require.NoError(t, err)
+
+			_, err = postHandler(ctx, txBuilder.GetTx(), false, tc.success)
+			require.NoError(t, err)
+
+			recipient, other := payer, granter
+			if tc.withGranter {
+				recipient, other = granter, payer
+			}
+			require.Equal(t, tc.expRefund, protonApp.BankKeeper.GetAllBalances(ctx, recipient))
+			require.True(t, protonApp.BankKeeper.GetAllBalances(ctx, other).IsZero())
+			feeCollector := protonApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			require.Equal(t, fee.Sub(tc.expRefund...), protonApp.BankKeeper.GetAllBalances(ctx, feeCollector))
+		})
+	}
+}
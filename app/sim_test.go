@@ -0,0 +1,403 @@
+package app_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/router/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/app/ante"
+	circuittypes "github.com/fatal-fruit/proton/x/circuit/types"
+	clientmonitortypes "github.com/fatal-fruit/proton/x/clientmonitor/types"
+	feemarkettypes "github.com/fatal-fruit/proton/x/feemarket/types"
+	feepolicytypes "github.com/fatal-fruit/proton/x/feepolicy/types"
+	globalfeetypes "github.com/fatal-fruit/proton/x/globalfee/types"
+	icaauthtypes "github.com/fatal-fruit/proton/x/icaauth/types"
+	icqtypes "github.com/fatal-fruit/proton/x/icq/types"
+	inflationtypes "github.com/fatal-fruit/proton/x/inflation/types"
+	nfttransfertypes "github.com/fatal-fruit/proton/x/nfttransfer/types"
+	ratelimittypes "github.com/fatal-fruit/proton/x/ratelimit/types"
+	refundtypes "github.com/fatal-fruit/proton/x/refund/types"
+	transferfiltertypes "github.com/fatal-fruit/proton/x/transferfilter/types"
+)
+
+// SimAppChainID hardcoded chainID for simulation
+const SimAppChainID = "simulation-app"
+
+// Get flags every time the simulator is run
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+type StoreKeysPrefixes struct {
+	A        storetypes.StoreKey
+	B        storetypes.StoreKey
+	Prefixes [][]byte
+}
+
+// fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of
+// an IAVLStore for faster simulation speed.
+func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
+	bapp.SetFauxMerkleMode()
+}
+
+// interBlockCacheOpt returns a BaseApp option function that sets the persistent
+// inter-block write-through cache.
+func interBlockCacheOpt() func(*baseapp.BaseApp) {
+	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
+}
+
+func simAppOptions() simtestutil.AppOptionsMap {
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = app.DefaultNodeHome
+	appOptions[server.FlagInvCheckPeriod] = simcli.FlagPeriodValue
+
+	return appOptions
+}
+
+// simulationOperations returns the weighted operations of all simulated
+// modules. The x/staking operations pick random commission rates which may be
+// below ante.MinCommissionRate; the ante handler rightfully rejects those txs
+// with ante.ErrCommissionTooLow, so they are reported as no-ops instead of
+// failing the simulation.
+func simulationOperations(protonApp *app.ProtonApp, config simtypes.Config) []simtypes.WeightedOperation {
+	ops := simtestutil.SimulationOperations(protonApp, protonApp.AppCodec(), config)
+	for i, op := range ops {
+		ops[i] = simulation.NewWeightedOperation(op.Weight(), skipLowCommission(op.Op()))
+	}
+
+	return ops
+}
+
+func skipLowCommission(op simtypes.Operation) simtypes.Operation {
+	return func(r *rand.Rand, bApp *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		opMsg, futureOps, err := op(r, bApp, ctx, accs, chainID)
+		if errors.Is(err, ante.ErrCommissionTooLow) {
+			return simtypes.NoOpMsg(opMsg.Route, opMsg.Name, "commission rate below the minimum"), nil, nil
+		}
+
+		return opMsg, futureOps, err
+	}
+}
+
+func simulateFromSeed(t *testing.T, protonApp *app.ProtonApp, config simtypes.Config) (bool, simtypes.Params, error) {
+	t.Helper()
+
+	return simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		protonApp.BaseApp,
+		simtestutil.AppStateFn(protonApp.AppCodec(), protonApp.SimulationManager(), protonApp.DefaultGenesis()),
+		simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
+		simulationOperations(protonApp, config),
+		app.BlockedAddresses(),
+		config,
+		protonApp.AppCodec(),
+	)
+}
+
+func TestFullAppSimulation(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(config, "leveldb-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	protonApp := app.NewProtonApp(logger, db, nil, true, simAppOptions(), app.RegisterEncodingConfig(), fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
+	require.Equal(t, app.AppName, protonApp.Name())
+
+	// run randomized simulation
+	_, simParams, simErr := simulateFromSeed(t, protonApp, config)
+
+	// export state and simParams before the simulation error is checked
+	err = simtestutil.CheckExportSimulation(protonApp, config, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simtestutil.PrintStats(db)
+	}
+}
+
+func TestAppImportExport(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(config, "leveldb-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	if skip {
+		t.Skip("skipping application import/export simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	protonApp := app.NewProtonApp(logger, db, nil, true, simAppOptions(), app.RegisterEncodingConfig(), fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
+	require.Equal(t, app.AppName, protonApp.Name())
+
+	// Run randomized simulation
+	_, simParams, simErr := simulateFromSeed(t, protonApp, config)
+
+	// export state and simParams before the simulation error is checked
+	err = simtestutil.CheckExportSimulation(protonApp, config, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simtestutil.PrintStats(db)
+	}
+
+	fmt.Printf("exporting genesis...\n")
+
+	exported, err := protonApp.ExportAppStateAndValidators(false, []string{}, []string{})
+	require.NoError(t, err)
+
+	fmt.Printf("importing genesis...\n")
+
+	newDB, newDir, _, _, err := simtestutil.SetupSimulation(config, "leveldb-app-sim-2", "Simulation-2", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, newDB.Close())
+		require.NoError(t, os.RemoveAll(newDir))
+	}()
+
+	newApp := app.NewProtonApp(log.NewNopLogger(), newDB, nil, true, simAppOptions(), app.RegisterEncodingConfig(), fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
+	require.Equal(t, app.AppName, newApp.Name())
+
+	var genesisState app.GenesisState
+	err = json.Unmarshal(exported.AppState, &genesisState)
+	require.NoError(t, err)
+
+	defer func() {
+		if r := recover(); r != nil {
+			err := fmt.Sprintf("%v", r)
+			if !strings.Contains(err, "validator set is empty after InitGenesis") {
+				panic(r)
+			}
+			logger.Info("Skipping simulation as all validators have been unbonded")
+			logger.Info("err", err, "stacktrace", string(debug.Stack()))
+		}
+	}()
+
+	ctxA := protonApp.NewContext(true, tmproto.Header{Height: protonApp.LastBlockHeight()})
+	ctxB := newApp.NewContext(true, tmproto.Header{Height: protonApp.LastBlockHeight()})
+	newApp.ModuleManager.InitGenesis(ctxB, protonApp.AppCodec(), genesisState)
+	newApp.StoreConsensusParams(ctxB, exported.ConsensusParams)
+
+	fmt.Printf("comparing stores...\n")
+
+	storeKeysPrefixes := []StoreKeysPrefixes{
+		{protonApp.GetKey(authtypes.StoreKey), newApp.GetKey(authtypes.StoreKey), [][]byte{}},
+		{
+			protonApp.GetKey(stakingtypes.StoreKey), newApp.GetKey(stakingtypes.StoreKey),
+			[][]byte{
+				stakingtypes.UnbondingQueueKey, stakingtypes.RedelegationQueueKey, stakingtypes.ValidatorQueueKey,
+				stakingtypes.HistoricalInfoKey, stakingtypes.UnbondingIDKey, stakingtypes.UnbondingIndexKey, stakingtypes.UnbondingTypeKey, stakingtypes.ValidatorUpdatesKey,
+			},
+		}, // ordering may change but it doesn't matter
+		{protonApp.GetKey(slashingtypes.StoreKey), newApp.GetKey(slashingtypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(minttypes.StoreKey), newApp.GetKey(minttypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(distrtypes.StoreKey), newApp.GetKey(distrtypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(banktypes.StoreKey), newApp.GetKey(banktypes.StoreKey), [][]byte{banktypes.BalancesPrefix}},
+		{protonApp.GetKey(paramstypes.StoreKey), newApp.GetKey(paramstypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(govtypes.StoreKey), newApp.GetKey(govtypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(evidencetypes.StoreKey), newApp.GetKey(evidencetypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(capabilitytypes.StoreKey), newApp.GetKey(capabilitytypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(authzkeeper.StoreKey), newApp.GetKey(authzkeeper.StoreKey), [][]byte{authzkeeper.GrantQueuePrefix}},
+		{protonApp.GetKey(feegrant.StoreKey), newApp.GetKey(feegrant.StoreKey), [][]byte{feegrant.FeeAllowanceQueueKeyPrefix}},
+		{protonApp.GetKey(group.StoreKey), newApp.GetKey(group.StoreKey), [][]byte{}},
+		{protonApp.GetKey(ibcexported.StoreKey), newApp.GetKey(ibcexported.StoreKey), [][]byte{}},
+		{protonApp.GetKey(ibctransfertypes.StoreKey), newApp.GetKey(ibctransfertypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(globalfeetypes.StoreKey), newApp.GetKey(globalfeetypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(feemarkettypes.StoreKey), newApp.GetKey(feemarkettypes.StoreKey), [][]byte{feemarkettypes.BlockGasUsedKey}},
+		{protonApp.GetKey(circuittypes.StoreKey), newApp.GetKey(circuittypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(icaauthtypes.StoreKey), newApp.GetKey(icaauthtypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(packetforwardtypes.StoreKey), newApp.GetKey(packetforwardtypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(ratelimittypes.StoreKey), newApp.GetKey(ratelimittypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(icqtypes.StoreKey), newApp.GetKey(icqtypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(nfttransfertypes.StoreKey), newApp.GetKey(nfttransfertypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(feepolicytypes.StoreKey), newApp.GetKey(feepolicytypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(clientmonitortypes.StoreKey), newApp.GetKey(clientmonitortypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(transferfiltertypes.StoreKey), newApp.GetKey(transferfiltertypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(inflationtypes.StoreKey), newApp.GetKey(inflationtypes.StoreKey), [][]byte{}},
+		{protonApp.GetKey(refundtypes.StoreKey), newApp.GetKey(refundtypes.StoreKey), [][]byte{}},
+	}
+
+	for _, skp := range storeKeysPrefixes {
+		storeA := ctxA.KVStore(skp.A)
+		storeB := ctxB.KVStore(skp.B)
+
+		failedKVAs, failedKVBs := sdk.DiffKVStores(storeA, storeB, skp.Prefixes)
+		require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare")
+
+		fmt.Printf("compared %d different key/value pairs between %s and %s\n", len(failedKVAs), skp.A, skp.B)
+		require.Equal(t, 0, len(failedKVAs), simtestutil.GetSimulationLog(skp.A.Name(), protonApp.SimulationManager().StoreDecoders, failedKVAs, failedKVBs))
+	}
+}
+
+func TestAppSimulationAfterImport(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(config, "leveldb-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	if skip {
+		t.Skip("skipping application simulation after import")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	protonApp := app.NewProtonApp(logger, db, nil, true, simAppOptions(), app.RegisterEncodingConfig(), fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
+	require.Equal(t, app.AppName, protonApp.Name())
+
+	// Run randomized simulation
+	stopEarly, simParams, simErr := simulateFromSeed(t, protonApp, config)
+
+	// export state and simParams before the simulation error is checked
+	err = simtestutil.CheckExportSimulation(protonApp, config, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simtestutil.PrintStats(db)
+	}
+
+	if stopEarly {
+		fmt.Println("can't export or import a zero-validator genesis, exiting test...")
+		return
+	}
+
+	fmt.Printf("exporting genesis...\n")
+
+	exported, err := protonApp.ExportAppStateAndValidators(true, []string{}, []string{})
+	require.NoError(t, err)
+
+	fmt.Printf("importing genesis...\n")
+
+	newDB, newDir, _, _, err := simtestutil.SetupSimulation(config, "leveldb-app-sim-2", "Simulation-2", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, newDB.Close())
+		require.NoError(t, os.RemoveAll(newDir))
+	}()
+
+	newApp := app.NewProtonApp(log.NewNopLogger(), newDB, nil, true, simAppOptions(), app.RegisterEncodingConfig(), fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
+	require.Equal(t, app.AppName, newApp.Name())
+
+	newApp.InitChain(abci.RequestInitChain{
+		ChainId:       SimAppChainID,
+		AppStateBytes: exported.AppState,
+	})
+
+	_, _, err = simulateFromSeed(t, newApp, config)
+	require.NoError(t, err)
+}
+
+// TODO: Make another test for the fuzzer itself, which just has noOp txs
+// and doesn't depend on the application.
+func TestAppStateDeterminism(t *testing.T) {
+	if !simcli.FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+
+	config := simcli.NewConfigFromFlags()
+	config.InitialBlockHeight = 1
+	config.ExportParamsPath = ""
+	config.OnOperation = false
+	config.AllInvariants = false
+	config.ChainID = SimAppChainID
+
+	numSeeds := 3
+	numTimesToRunPerSeed := 5
+	appHashList := make([]json.RawMessage, numTimesToRunPerSeed)
+
+	for i := 0; i < numSeeds; i++ {
+		config.Seed = rand.Int63()
+
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			var logger log.Logger
+			if simcli.FlagVerboseValue {
+				logger = log.TestingLogger()
+			} else {
+				logger = log.NewNopLogger()
+			}
+
+			db := dbm.NewMemDB()
+			protonApp := app.NewProtonApp(logger, db, nil, true, simAppOptions(), app.RegisterEncodingConfig(), interBlockCacheOpt(), baseapp.SetChainID(SimAppChainID))
+
+			fmt.Printf(
+				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
+				config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+			)
+
+			_, _, err := simulateFromSeed(t, protonApp, config)
+			require.NoError(t, err)
+
+			if config.Commit {
+				simtestutil.PrintStats(db)
+			}
+
+			appHash := protonApp.LastCommitID().Hash
+			appHashList[j] = appHash
+
+			if j != 0 {
+				require.Equal(
+					t, string(appHashList[0]), string(appHashList[j]),
+					"non-determinism in seed %d: %d/%d, attempt: %d/%d\n", config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+				)
+			}
+		}
+	}
+}
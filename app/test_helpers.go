@@ -0,0 +1,292 @@
+package app
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app/upgrades"
+	globalfeetypes "github.com/fatal-fruit/proton/x/globalfee/types"
+)
+
+// TestChainID is the chain ID of apps created by Setup and SetupWithGenesisValSet.
+const TestChainID = "proton-test-1"
+
+var _ ibctesting.TestingApp = (*ProtonApp)(nil)
+
+// DefaultConsensusParams defines the default Tendermint consensus params used in
+// ProtonApp testing.
+var DefaultConsensusParams = &tmproto.ConsensusParams{
+	Block: &tmproto.BlockParams{
+		MaxBytes: 200000,
+		MaxGas:   -1,
+	},
+	Evidence: &tmproto.EvidenceParams{
+		MaxAgeNumBlocks: 302400,
+		MaxAgeDuration:  504 * time.Hour, // 3 weeks is the max duration
+		MaxBytes:        10000,
+	},
+	Validator: &tmproto.ValidatorParams{
+		PubKeyTypes: []string{
+			tmtypes.ABCIPubKeyTypeEd25519,
+		},
+	},
+}
+
+func setup(withGenesis bool, baseAppOptions ...func(*baseapp.BaseApp)) (*ProtonApp, GenesisState) {
+	db := dbm.NewMemDB()
+	app := NewProtonApp(log.NewNopLogger(), db, nil, true, simtestutil.EmptyAppOptions{}, RegisterEncodingConfig(), baseAppOptions...)
+	if withGenesis {
+		return app, NewDefaultGenesisState(app.AppCodec())
+	}
+	return app, GenesisState{}
+}
+
+// SetupTestingApp initializes the IBC-go testing application. Assign it to
+// ibctesting.DefaultTestingAppInit to run ibctesting suites against proton.
+// ibctesting signs every tx with simtestutil.DefaultGenTxGas, so the bypass
+// gas limit of x/globalfee is raised to let the relayer txs through.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	app, genesisState := setup(true)
+
+	globalFeeGenesis := globalfeetypes.DefaultGenesisState()
+	globalFeeGenesis.Params.MaxTotalBypassMinFeeMsgGasUsage = simtestutil.DefaultGenTxGas
+	genesisState[globalfeetypes.ModuleName] = app.AppCodec().MustMarshalJSON(globalFeeGenesis)
+
+	return app, genesisState
+}
+
+// Setup initializes a new ProtonApp with a single validator and a funded
+// genesis account. A Nop logger is set in ProtonApp.
+func Setup(t *testing.T) *ProtonApp {
+	t.Helper()
+
+	// create validator set with single validator
+	validator := tmtypes.NewValidator(ed25519.GenPrivKey().PubKey(), 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+
+	// generate genesis account
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+
+	return SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc}, balance)
+}
+
+// SetupWithGenesisValSet initializes a new ProtonApp with a validator set and genesis accounts
+// that also act as delegators. For simplicity, each validator is bonded with a delegation
+// of one consensus engine unit in the default token of the app from first genesis
+// account. The genesis block is committed and the second block begun, so txs
+// can be delivered right away. A Nop logger is set in ProtonApp.
+func SetupWithGenesisValSet(t *testing.T, valSet *tmtypes.ValidatorSet, genAccs []authtypes.GenesisAccount, balances ...banktypes.Balance) *ProtonApp {
+	t.Helper()
+
+	app, genesisState := setup(true, baseapp.SetChainID(TestChainID))
+	genesisState, err := GenesisStateWithValSet(app, genesisState, valSet, genAccs, balances...)
+	require.NoError(t, err)
+
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	// init chain will set the validator set and initialize the genesis accounts
+	app.InitChain(
+		abci.RequestInitChain{
+			ChainId:         TestChainID,
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: DefaultConsensusParams,
+			AppStateBytes:   stateBytes,
+		},
+	)
+
+	// commit genesis changes
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+		ChainID:            TestChainID,
+		Height:             app.LastBlockHeight() + 1,
+		AppHash:            app.LastCommitID().Hash,
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: valSet.Hash(),
+	}})
+
+	return app
+}
+
+// GenesisStateWithValSet returns a new genesis state with the validator set
+// bonded by the first genesis account and the given balances.
+func GenesisStateWithValSet(app *ProtonApp, genesisState GenesisState,
+	valSet *tmtypes.ValidatorSet, genAccs []authtypes.GenesisAccount,
+	balances ...banktypes.Balance,
+) (GenesisState, error) {
+	// set genesis accounts
+	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs)
+	genesisState[authtypes.ModuleName] = app.AppCodec().MustMarshalJSON(authGenesis)
+
+	validators := make([]stakingtypes.Validator, 0, len(valSet.Validators))
+	delegations := make([]stakingtypes.Delegation, 0, len(valSet.Validators))
+
+	bondAmt := sdk.DefaultPowerReduction
+
+	for _, val := range valSet.Validators {
+		pk, err := cryptocodec.FromTmPubKeyInterface(val.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		pkAny, err := codectypes.NewAnyWithValue(pk)
+		if err != nil {
+			return nil, err
+		}
+		validator := stakingtypes.Validator{
+			OperatorAddress:   sdk.ValAddress(val.Address).String(),
+			ConsensusPubkey:   pkAny,
+			Jailed:            false,
+			Status:            stakingtypes.Bonded,
+			Tokens:            bondAmt,
+			DelegatorShares:   sdk.OneDec(),
+			Description:       stakingtypes.Description{},
+			UnbondingHeight:   int64(0),
+			UnbondingTime:     time.Unix(0, 0).UTC(),
+			Commission:        stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+			MinSelfDelegation: sdk.ZeroInt(),
+		}
+		validators = append(validators, validator)
+		delegations = append(delegations, stakingtypes.NewDelegation(genAccs[0].GetAddress(), val.Address.Bytes(), sdk.OneDec()))
+	}
+
+	// set validators and delegations
+	stakingGenesis := stakingtypes.NewGenesisState(stakingtypes.DefaultParams(), validators, delegations)
+	genesisState[stakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(stakingGenesis)
+
+	totalSupply := sdk.NewCoins()
+	for _, b := range balances {
+		// add genesis acc tokens to total supply
+		totalSupply = totalSupply.Add(b.Coins...)
+	}
+
+	for range delegations {
+		// add delegated tokens to total supply
+		totalSupply = totalSupply.Add(sdk.NewCoin(sdk.DefaultBondDenom, bondAmt))
+	}
+
+	// add bonded amount to bonded pool module account
+	balances = append(balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
+		Coins:   sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, bondAmt.MulRaw(int64(len(delegations))))},
+	})
+
+	// update total supply
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{}, []banktypes.SendEnabled{})
+	genesisState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(bankGenesis)
+
+	return genesisState, nil
+}
+
+// AddTestAddrs creates accNum new accounts, funds each of them with amount of
+// the default bond denom and returns their private keys and addresses.
+func AddTestAddrs(t *testing.T, app *ProtonApp, ctx sdk.Context, accNum int, amount math.Int) ([]cryptotypes.PrivKey, []sdk.AccAddress) {
+	t.Helper()
+
+	privs := make([]cryptotypes.PrivKey, accNum)
+	addrs := make([]sdk.AccAddress, accNum)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+
+	for i := 0; i < accNum; i++ {
+		privs[i] = secp256k1.GenPrivKey()
+		addrs[i] = sdk.AccAddress(privs[i].PubKey().Address())
+		require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, addrs[i], coins))
+	}
+
+	return privs, addrs
+}
+
+// SignAndDeliver signs and delivers a transaction paying fees. No simulation
+// occurs as the ibc testing package causes checkState and deliverState to
+// diverge in block time.
+//
+// CONTRACT: BeginBlock must be called before this function.
+func SignAndDeliver(
+	t *testing.T, txCfg client.TxConfig, app *baseapp.BaseApp, msgs []sdk.Msg, fees sdk.Coins,
+	chainID string, accNums, accSeqs []uint64, expPass bool, priv ...cryptotypes.PrivKey,
+) (sdk.GasInfo, *sdk.Result, error) {
+	t.Helper()
+
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(time.Now().UnixNano())),
+		txCfg,
+		msgs,
+		fees,
+		simtestutil.DefaultGenTxGas,
+		chainID,
+		accNums,
+		accSeqs,
+		priv...,
+	)
+	require.NoError(t, err)
+
+	gInfo, res, err := app.SimDeliver(txCfg.TxEncoder(), tx)
+
+	if expPass {
+		require.NoError(t, err)
+		require.NotNil(t, res)
+	} else {
+		require.Error(t, err)
+		require.Nil(t, res)
+	}
+
+	return gInfo, res, err
+}
+
+// RunUpgrade schedules upgrade on a committed app, commits blocks up to the
+// upgrade height so its handler runs in the upgrade BeginBlocker, and returns
+// the module version map it stored.
+//
+// CONTRACT: BeginBlock must be called before this function.
+func RunUpgrade(t *testing.T, app *ProtonApp, upgrade upgrades.Upgrade) module.VersionMap {
+	t.Helper()
+
+	header := tmproto.Header{ChainID: TestChainID, Height: app.LastBlockHeight() + 1}
+	ctx := app.BaseApp.NewContext(false, header)
+	plan := upgradetypes.Plan{Name: upgrade.UpgradeName, Height: header.Height + 1}
+	require.NoError(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	header.Height = plan.Height
+	require.NotPanics(t, func() {
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	ctx = app.BaseApp.NewContext(true, header)
+	require.Equal(t, plan.Height, app.UpgradeKeeper.GetDoneHeight(ctx, upgrade.UpgradeName))
+
+	return app.UpgradeKeeper.GetModuleVersionMap(ctx)
+}
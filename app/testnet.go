@@ -0,0 +1,180 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/fatal-fruit/proton/app/ante"
+)
+
+// TestnetValidatorPower is the consensus power of the single validator of an
+// in-place testnet.
+const TestnetValidatorPower int64 = 900_000_000
+
+// TestnetConfig describes how InitForTestnet turns the state of an existing
+// chain into a single validator testnet.
+type TestnetConfig struct {
+	// ValidatorPubKey is the consensus key of the new validator.
+	ValidatorPubKey cryptotypes.PubKey
+	// ValidatorOperator is the operator of the new validator. Its account
+	// holds the validator self-delegation.
+	ValidatorOperator sdk.ValAddress
+	// AccountsToFund each receive FundCoins.
+	AccountsToFund []sdk.AccAddress
+	// FundCoins defaults to one million bond tokens.
+	FundCoins sdk.Coins
+	// VotingPeriod replaces the governance voting period if set.
+	VotingPeriod time.Duration
+}
+
+// InitForTestnet replaces the validator set of the loaded state with a single
+// validator, funds the test accounts and shortens the governance voting
+// period. The old validators are unbonded and dropped from x/slashing. The
+// changes are written to the working state and committed with the next
+// block, so the app hash at the loaded height is left untouched.
+func (app *ProtonApp) InitForTestnet(cfg TestnetConfig) error {
+	ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	if _, found := app.StakingKeeper.GetValidator(ctx, cfg.ValidatorOperator); found {
+		return fmt.Errorf("validator %s already exists", cfg.ValidatorOperator)
+	}
+
+	/* Handle staking state. */
+
+	// remove every validator from the power index and the last validator set,
+	// so none of them is part of a validator set update again
+	stakingStore := ctx.KVStore(app.GetKey(stakingtypes.StoreKey))
+	for _, iter := range []sdk.Iterator{
+		app.StakingKeeper.ValidatorsPowerStoreIterator(ctx),
+		app.StakingKeeper.LastValidatorsIterator(ctx),
+	} {
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			stakingStore.Delete(key)
+		}
+	}
+
+	// unbond the old validators and move their tokens to the not bonded pool
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	var oldConsAddrs []sdk.ConsAddress
+	unbondedTokens := sdkmath.ZeroInt()
+	for _, oldValidator := range app.StakingKeeper.GetAllValidators(ctx) {
+		if !oldValidator.IsBonded() {
+			continue
+		}
+
+		consAddr, err := oldValidator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		oldConsAddrs = append(oldConsAddrs, consAddr)
+		unbondedTokens = unbondedTokens.Add(oldValidator.Tokens)
+
+		app.StakingKeeper.SetValidator(ctx, oldValidator.UpdateStatus(stakingtypes.Unbonded))
+	}
+	unbonded := sdk.NewCoins(sdk.NewCoin(bondDenom, unbondedTokens))
+	if err := app.BankKeeper.SendCoinsFromModuleToModule(ctx, stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, unbonded); err != nil {
+		return err
+	}
+
+	tokens := app.StakingKeeper.TokensFromConsensusPower(ctx, TestnetValidatorPower)
+	bonded := sdk.NewCoins(sdk.NewCoin(bondDenom, tokens))
+	if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, bonded); err != nil {
+		return err
+	}
+	if err := app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, stakingtypes.BondedPoolName, bonded); err != nil {
+		return err
+	}
+
+	validator, err := stakingtypes.NewValidator(cfg.ValidatorOperator, cfg.ValidatorPubKey, stakingtypes.Description{Moniker: "testnet"})
+	if err != nil {
+		return err
+	}
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = tokens
+	validator.DelegatorShares = sdk.NewDecFromInt(tokens)
+	validator.MinSelfDelegation = sdkmath.OneInt()
+	validator.Commission = stakingtypes.NewCommission(ante.MinCommissionRate, sdk.OneDec(), sdk.OneDec())
+
+	app.StakingKeeper.SetValidator(ctx, validator)
+	if err := app.StakingKeeper.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+	if err := app.StakingKeeper.Hooks().AfterValidatorCreated(ctx, cfg.ValidatorOperator); err != nil {
+		return err
+	}
+
+	delegator := sdk.AccAddress(cfg.ValidatorOperator)
+	if err := app.StakingKeeper.Hooks().BeforeDelegationCreated(ctx, delegator, cfg.ValidatorOperator); err != nil {
+		return err
+	}
+	app.StakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(delegator, cfg.ValidatorOperator, validator.DelegatorShares))
+	if err := app.StakingKeeper.Hooks().AfterDelegationModified(ctx, delegator, cfg.ValidatorOperator); err != nil {
+		return err
+	}
+
+	/* Handle slashing state. */
+
+	// forget the signing infos and missed blocks of the old validators
+	slashingStore := ctx.KVStore(app.GetKey(slashingtypes.StoreKey))
+	for _, oldConsAddr := range oldConsAddrs {
+		slashingStore.Delete(slashingtypes.ValidatorSigningInfoKey(oldConsAddr))
+
+		iter := sdk.KVStorePrefixIterator(slashingStore, slashingtypes.ValidatorMissedBlockBitArrayPrefixKey(oldConsAddr))
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			slashingStore.Delete(key)
+		}
+	}
+
+	consAddr := sdk.ConsAddress(cfg.ValidatorPubKey.Address())
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(
+		consAddr, ctx.BlockHeight(), 0, time.Unix(0, 0), false, 0,
+	))
+
+	/* Handle bank state. */
+
+	fundCoins := cfg.FundCoins
+	if fundCoins.Empty() {
+		fundCoins = sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(1_000_000, sdk.DefaultPowerReduction)))
+	}
+	for _, addr := range cfg.AccountsToFund {
+		if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fundCoins); err != nil {
+			return err
+		}
+		if err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, fundCoins); err != nil {
+			return err
+		}
+	}
+
+	/* Handle gov state. */
+
+	if cfg.VotingPeriod > 0 {
+		params := app.GovKeeper.GetParams(ctx)
+		params.VotingPeriod = &cfg.VotingPeriod
+		if err := app.GovKeeper.SetParams(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
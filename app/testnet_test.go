@@ -0,0 +1,77 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+)
+
+func TestInitForTestnet(t *testing.T) {
+	protonApp := app.Setup(t)
+	protonApp.EndBlock(abci.RequestEndBlock{})
+	protonApp.Commit()
+
+	ctx := protonApp.NewContext(true, tmproto.Header{})
+	oldValidators := protonApp.StakingKeeper.GetLastValidators(ctx)
+	require.Len(t, oldValidators, 1)
+	oldConsAddr, err := oldValidators[0].GetConsAddr()
+	require.NoError(t, err)
+	protonApp.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, oldConsAddr, 0, true)
+
+	valPubKey := ed25519.GenPrivKey().PubKey()
+	operator := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, protonApp.InitForTestnet(app.TestnetConfig{
+		ValidatorPubKey:   valPubKey,
+		ValidatorOperator: operator,
+		AccountsToFund:    []sdk.AccAddress{account},
+		VotingPeriod:      time.Minute,
+	}))
+
+	// the testnet changes are committed with the next block
+	protonApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+		ChainID: app.TestChainID,
+		Height:  protonApp.LastBlockHeight() + 1,
+	}})
+	res := protonApp.EndBlock(abci.RequestEndBlock{})
+	protonApp.Commit()
+
+	require.Len(t, res.ValidatorUpdates, 1)
+	require.Equal(t, app.TestnetValidatorPower, res.ValidatorUpdates[0].Power)
+
+	ctx = protonApp.NewContext(true, tmproto.Header{})
+	lastValidators := protonApp.StakingKeeper.GetLastValidators(ctx)
+	require.Len(t, lastValidators, 1)
+	require.Equal(t, operator.String(), lastValidators[0].OperatorAddress)
+
+	_, found := protonApp.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(valPubKey.Address()))
+	require.True(t, found)
+
+	// the old validator is unbonded and forgotten by x/slashing
+	oldValidator, found := protonApp.StakingKeeper.GetValidator(ctx, oldValidators[0].GetOperator())
+	require.True(t, found)
+	require.Equal(t, stakingtypes.Unbonded, oldValidator.Status)
+	notBondedPool := protonApp.StakingKeeper.GetNotBondedPool(ctx)
+	require.Equal(t, oldValidator.Tokens, protonApp.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), protonApp.StakingKeeper.BondDenom(ctx)).Amount)
+	_, found = protonApp.SlashingKeeper.GetValidatorSigningInfo(ctx, oldConsAddr)
+	require.False(t, found)
+	require.Empty(t, protonApp.SlashingKeeper.GetValidatorMissedBlocks(ctx, oldConsAddr))
+	require.False(t, protonApp.BankKeeper.GetAllBalances(ctx, account).IsZero())
+	require.Equal(t, time.Minute, *protonApp.GovKeeper.GetParams(ctx).VotingPeriod)
+	require.NotPanics(t, func() { protonApp.CrisisKeeper.AssertInvariants(ctx) })
+
+	// the operator must not already run a validator
+	require.Error(t, protonApp.InitForTestnet(app.TestnetConfig{
+		ValidatorPubKey:   ed25519.GenPrivKey().PubKey(),
+		ValidatorOperator: operator,
+	}))
+}
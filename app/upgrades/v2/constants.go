@@ -0,0 +1,47 @@
+package v2
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/router/types"
+
+	"github.com/fatal-fruit/proton/app/upgrades"
+	circuittypes "github.com/fatal-fruit/proton/x/circuit/types"
+	clientmonitortypes "github.com/fatal-fruit/proton/x/clientmonitor/types"
+	feemarkettypes "github.com/fatal-fruit/proton/x/feemarket/types"
+	feepolicytypes "github.com/fatal-fruit/proton/x/feepolicy/types"
+	globalfeetypes "github.com/fatal-fruit/proton/x/globalfee/types"
+	icaauthtypes "github.com/fatal-fruit/proton/x/icaauth/types"
+	icqtypes "github.com/fatal-fruit/proton/x/icq/types"
+	inflationtypes "github.com/fatal-fruit/proton/x/inflation/types"
+	nfttransfertypes "github.com/fatal-fruit/proton/x/nfttransfer/types"
+	ratelimittypes "github.com/fatal-fruit/proton/x/ratelimit/types"
+	refundtypes "github.com/fatal-fruit/proton/x/refund/types"
+	transferfiltertypes "github.com/fatal-fruit/proton/x/transferfilter/types"
+)
+
+const (
+	// UpgradeName defines the on-chain upgrade name.
+	UpgradeName = "v2"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{
+			globalfeetypes.StoreKey,
+			feemarkettypes.StoreKey,
+			circuittypes.StoreKey,
+			icaauthtypes.StoreKey,
+			packetforwardtypes.StoreKey,
+			ratelimittypes.StoreKey,
+			icqtypes.StoreKey,
+			nfttransfertypes.StoreKey,
+			feepolicytypes.StoreKey,
+			clientmonitortypes.StoreKey,
+			transferfiltertypes.StoreKey,
+			inflationtypes.StoreKey,
+			refundtypes.StoreKey,
+		},
+	},
+}
@@ -0,0 +1,92 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/fatal-fruit/proton/app/keepers"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	baseAppLegacySS := keepers.ParamsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(paramstypes.ConsensusParamsKeyTable())
+
+	return func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		MigrateConsensusParams(ctx, baseAppLegacySS, keepers)
+
+		versionMap, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := SetFeeDenom(ctx, keepers); err != nil {
+			return nil, err
+		}
+
+		if err := EnableLocalhostClient(ctx, keepers); err != nil {
+			return nil, err
+		}
+
+		return versionMap, nil
+	}
+}
+
+// MigrateConsensusParams moves the Tendermint consensus parameters into the
+// x/consensus store. The previous release kept them in the x/upgrade store,
+// where they take precedence; otherwise they are migrated from their x/params
+// subspace.
+func MigrateConsensusParams(ctx sdk.Context, legacySubspace paramstypes.Subspace, keepers *keepers.AppKeepers) {
+	upgradeStore := ctx.KVStore(keepers.GetKey(upgradetypes.StoreKey))
+	bz := upgradeStore.Get(consensusparamtypes.ParamStoreKeyConsensusParams)
+	if bz == nil {
+		baseapp.MigrateParams(ctx, legacySubspace, &keepers.ConsensusParamsKeeper)
+		return
+	}
+
+	consensusStore := ctx.KVStore(keepers.GetKey(consensusparamtypes.StoreKey))
+	consensusStore.Set(consensusparamtypes.ParamStoreKeyConsensusParams, bz)
+	upgradeStore.Delete(consensusparamtypes.ParamStoreKeyConsensusParams)
+}
+
+// SetFeeDenom charges the base fee of x/feemarket in the bond denom, rather
+// than the SDK default denom its genesis was initialized with.
+func SetFeeDenom(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	params := keepers.FeeMarketKeeper.GetParams(ctx)
+	params.FeeDenom = keepers.StakingKeeper.BondDenom(ctx)
+
+	return keepers.FeeMarketKeeper.SetParams(ctx, params)
+}
+
+// EnableLocalhostClient allows the 09-localhost client and creates it, along
+// with its sentinel connection, if the IBC migrations did not. Chains which
+// started on ibc-go v7.1 or later skip those migrations and may have been
+// launched with a genesis disallowing the client.
+func EnableLocalhostClient(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	clientKeeper := keepers.IBCKeeper.ClientKeeper
+
+	params := clientKeeper.GetParams(ctx)
+	if !params.IsAllowedClient(ibcexported.Localhost) {
+		params.AllowedClients = append(params.AllowedClients, ibcexported.Localhost)
+		clientKeeper.SetParams(ctx, params)
+	}
+
+	if _, found := clientKeeper.GetClientState(ctx, ibcexported.LocalhostClientID); !found {
+		if err := clientKeeper.CreateLocalhostClient(ctx); err != nil {
+			return err
+		}
+	}
+
+	if _, found := keepers.IBCKeeper.ConnectionKeeper.GetConnection(ctx, ibcexported.LocalhostConnectionID); !found {
+		keepers.IBCKeeper.ConnectionKeeper.CreateSentinelLocalhostConnection(ctx)
+	}
+
+	return nil
+}
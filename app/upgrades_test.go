@@ -0,0 +1,130 @@
+package app_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	v2 "github.com/fatal-fruit/proton/app/upgrades/v2"
+)
+
+func TestUpgrades(t *testing.T) {
+	for _, upgrade := range app.Upgrades {
+		upgrade := upgrade
+		t.Run(upgrade.UpgradeName, func(t *testing.T) {
+			protonApp := app.Setup(t)
+
+			for _, storeKey := range upgrade.StoreUpgrades.Added {
+				require.NotNil(t, protonApp.GetKey(storeKey), "added store %s is not mounted", storeKey)
+			}
+
+			versionMap := app.RunUpgrade(t, protonApp, upgrade)
+			require.Equal(t, protonApp.ModuleManager.GetVersionMap(), versionMap)
+		})
+	}
+}
+
+func TestV2MigratesConsensusParams(t *testing.T) {
+	protonApp := app.Setup(t)
+	protonApp.EndBlock(abci.RequestEndBlock{})
+	protonApp.Commit()
+
+	// move the consensus params to the upgrade store, where they were kept
+	// before v2
+	ctx := protonApp.NewUncachedContext(false, tmproto.Header{})
+	upgradeStore := ctx.KVStore(protonApp.GetKey(upgradetypes.StoreKey))
+	consensusStore := ctx.KVStore(protonApp.GetKey(consensusparamtypes.StoreKey))
+	bz := consensusStore.Get(consensusparamtypes.ParamStoreKeyConsensusParams)
+	require.NotNil(t, bz)
+	upgradeStore.Set(consensusparamtypes.ParamStoreKeyConsensusParams, bz)
+	consensusStore.Delete(consensusparamtypes.ParamStoreKeyConsensusParams)
+
+	protonApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{ChainID: app.TestChainID, Height: protonApp.LastBlockHeight() + 1}})
+	app.RunUpgrade(t, protonApp, v2.Upgrade)
+
+	ctx = protonApp.NewContext(true, tmproto.Header{})
+	require.False(t, ctx.KVStore(protonApp.GetKey(upgradetypes.StoreKey)).Has(consensusparamtypes.ParamStoreKeyConsensusParams))
+	require.Equal(t, bz, ctx.KVStore(protonApp.GetKey(consensusparamtypes.StoreKey)).Get(consensusparamtypes.ParamStoreKeyConsensusParams))
+
+	res, err := consensusparamkeeper.NewQuerier(protonApp.ConsensusParamsKeeper).Params(sdk.WrapSDKContext(ctx), &consensusparamtypes.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, app.DefaultConsensusParams.Block.MaxGas, res.Params.Block.MaxGas)
+}
+
+func TestV2MigratesLegacyConsensusParams(t *testing.T) {
+	protonApp := app.Setup(t)
+	protonApp.EndBlock(abci.RequestEndBlock{})
+	protonApp.Commit()
+
+	// keep the consensus params in the x/params subspace of BaseApp only
+	ctx := protonApp.NewUncachedContext(false, tmproto.Header{})
+	legacySubspace, found := protonApp.ParamsKeeper.GetSubspace(baseapp.Paramspace)
+	require.True(t, found)
+	legacySubspace.Set(ctx, baseapp.ParamStoreKeyBlockParams, *app.DefaultConsensusParams.Block)
+	legacySubspace.Set(ctx, baseapp.ParamStoreKeyEvidenceParams, *app.DefaultConsensusParams.Evidence)
+	legacySubspace.Set(ctx, baseapp.ParamStoreKeyValidatorParams, *app.DefaultConsensusParams.Validator)
+	ctx.KVStore(protonApp.GetKey(consensusparamtypes.StoreKey)).Delete(consensusparamtypes.ParamStoreKeyConsensusParams)
+
+	protonApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{ChainID: app.TestChainID, Height: protonApp.LastBlockHeight() + 1}})
+	app.RunUpgrade(t, protonApp, v2.Upgrade)
+
+	ctx = protonApp.NewContext(true, tmproto.Header{})
+	res, err := consensusparamkeeper.NewQuerier(protonApp.ConsensusParamsKeeper).Params(sdk.WrapSDKContext(ctx), &consensusparamtypes.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, app.DefaultConsensusParams.Block, res.Params.Block)
+	require.Equal(t, app.DefaultConsensusParams.Validator, res.Params.Validator)
+}
+
+func TestV2SetsFeeDenom(t *testing.T) {
+	protonApp := app.Setup(t)
+	protonApp.EndBlock(abci.RequestEndBlock{})
+	protonApp.Commit()
+
+	ctx := protonApp.NewUncachedContext(false, tmproto.Header{})
+	stakingParams := protonApp.StakingKeeper.GetParams(ctx)
+	stakingParams.BondDenom = "uproton"
+	require.NoError(t, protonApp.StakingKeeper.SetParams(ctx, stakingParams))
+
+	protonApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{ChainID: app.TestChainID, Height: protonApp.LastBlockHeight() + 1}})
+	app.RunUpgrade(t, protonApp, v2.Upgrade)
+
+	ctx = protonApp.NewContext(true, tmproto.Header{})
+	require.Equal(t, "uproton", protonApp.FeeMarketKeeper.GetParams(ctx).FeeDenom)
+}
+
+func TestV2EnablesLocalhostClient(t *testing.T) {
+	protonApp := app.Setup(t)
+	protonApp.EndBlock(abci.RequestEndBlock{})
+	protonApp.Commit()
+
+	// launch without the 09-localhost client, as a genesis disallowing it
+	// would
+	ctx := protonApp.NewUncachedContext(false, tmproto.Header{})
+	clientKeeper := protonApp.IBCKeeper.ClientKeeper
+	clientKeeper.SetParams(ctx, clienttypes.NewParams(ibcexported.Solomachine, ibcexported.Tendermint))
+	clientKeeper.ClientStore(ctx, ibcexported.LocalhostClientID).Delete(host.ClientStateKey())
+
+	protonApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{ChainID: app.TestChainID, Height: protonApp.LastBlockHeight() + 1}})
+	app.RunUpgrade(t, protonApp, v2.Upgrade)
+
+	ctx = protonApp.NewContext(true, tmproto.Header{})
+	require.True(t, clientKeeper.GetParams(ctx).IsAllowedClient(ibcexported.Localhost))
+
+	clientState, found := clientKeeper.GetClientState(ctx, ibcexported.LocalhostClientID)
+	require.True(t, found)
+	require.Equal(t, ibcexported.Active, clientKeeper.GetClientStatus(ctx, clientState, ibcexported.LocalhostClientID))
+
+	_, found = protonApp.IBCKeeper.ConnectionKeeper.GetConnection(ctx, ibcexported.LocalhostConnectionID)
+	require.True(t, found)
+}
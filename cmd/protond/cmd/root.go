@@ -25,12 +25,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/fatal-fruit/proton/app"
+	clientmonitorcli "github.com/fatal-fruit/proton/x/clientmonitor/client/cli"
 )
 
+// FlagStreamingExportDir makes the export command write the genesis of each
+// module to its own file in the given directory instead of into the genesis
+// document.
+const FlagStreamingExportDir = "streaming-dir"
+
 // NewRootCmd creates a new root command for a Cosmos SDK application
 func NewRootCmd() (*cobra.Command, app.EncodingConfig) {
 	encodingConfig := app.RegisterEncodingConfig()
@@ -139,6 +146,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig, sdkC
 	)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, ac.newApp, ac.appExport, addModuleInitFlags)
+	addExportFlags(rootCmd)
+	rootCmd.AddCommand(inPlaceTestnetCommand(ac))
 
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
@@ -200,6 +209,7 @@ func txCommand() *cobra.Command {
 	)
 
 	app.ModuleBasics.AddTxCommands(cmd)
+	cmd.AddCommand(clientmonitorcli.NewIBCRecoverCmd())
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
 
 	return cmd
@@ -209,6 +219,16 @@ func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 }
 
+// addExportFlags adds the streaming export flag to the export command.
+func addExportFlags(rootCmd *cobra.Command) {
+	exportCmd, _, err := rootCmd.Find([]string{"export"})
+	if err != nil {
+		panic(err)
+	}
+
+	exportCmd.Flags().String(FlagStreamingExportDir, "", "Stream the genesis of each module to its own file in this directory, so that export and import hold one module genesis in memory at a time; a relative path is resolved against the home directory")
+}
+
 // genesisCommand builds genesis-related `simd genesis` command. Users may provide application specific commands as a parameter
 func genesisCommand(encodingConfig app.EncodingConfig, cmds ...*cobra.Command) *cobra.Command {
 	cmd := genutilcli.GenesisCoreCommand(encodingConfig.TxConfig, app.ModuleBasics, app.DefaultNodeHome)
@@ -281,5 +301,9 @@ func (a appCreator) appExport(
 		protonApp = app.NewProtonApp(logger, db, traceStore, true, appOpts, a.encCfg)
 	}
 
+	if dir := cast.ToString(appOpts.Get(FlagStreamingExportDir)); dir != "" {
+		return protonApp.ExportAppStateAndValidatorsToDir(forZeroHeight, jailAllowedAddrs, modulesToExport, dir)
+	}
+
 	return protonApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
 }
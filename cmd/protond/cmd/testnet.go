@@ -0,0 +1,232 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmcfg "github.com/cometbft/cometbft/config"
+	tmcrypto "github.com/cometbft/cometbft/crypto"
+	tmjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/privval"
+	tmstate "github.com/cometbft/cometbft/proto/tendermint/state"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/fatal-fruit/proton/app"
+)
+
+const (
+	flagAccountsToFund = "accounts-to-fund"
+	flagFundCoins      = "fund-coins"
+	flagVotingPeriod   = "voting-period"
+)
+
+// inPlaceTestnetCommand returns a start command which first turns the node's
+// data directory into a single validator testnet run by the node's own
+// validator key.
+func inPlaceTestnetCommand(ac appCreator) *cobra.Command {
+	var testnetCfg app.TestnetConfig
+
+	cmd := server.StartCmd(func(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+		protonApp := ac.newApp(logger, db, traceStore, appOpts).(*app.ProtonApp)
+		if err := protonApp.InitForTestnet(testnetCfg); err != nil {
+			panic(err)
+		}
+
+		return protonApp
+	}, app.DefaultNodeHome)
+
+	cmd.Use = "in-place-testnet [new-chain-id] [new-operator-address]"
+	cmd.Short = "Turn the node's state into a single validator testnet and start it"
+	cmd.Long = `Load the state of an existing node and replace its validator set with a
+single validator, signing with the node's own validator key. The staking
+validator set, the consensus validator set in the blockstore and the slashing
+signing infos are replaced, the given accounts are funded and the governance
+voting period is lowered. The node is then started on the new chain ID.
+
+Back up the data directory first: the node cannot rejoin its original network
+afterwards.`
+	cmd.Example = "protond in-place-testnet proton-testnet-1 protonvaloper1... --accounts-to-fund=proton1...,proton1..."
+	cmd.Args = cobra.ExactArgs(2)
+
+	startPreRunE := cmd.PreRunE
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := startPreRunE(cmd, args); err != nil {
+			return err
+		}
+
+		serverCtx := server.GetServerContextFromCmd(cmd)
+
+		newChainID := args[0]
+		operator, err := sdk.ValAddressFromBech32(args[1])
+		if err != nil {
+			return err
+		}
+		testnetCfg.ValidatorOperator = operator
+
+		accounts, err := cmd.Flags().GetStringSlice(flagAccountsToFund)
+		if err != nil {
+			return err
+		}
+		for _, account := range accounts {
+			addr, err := sdk.AccAddressFromBech32(account)
+			if err != nil {
+				return err
+			}
+			testnetCfg.AccountsToFund = append(testnetCfg.AccountsToFund, addr)
+		}
+
+		fundCoins, err := cmd.Flags().GetString(flagFundCoins)
+		if err != nil {
+			return err
+		}
+		if testnetCfg.FundCoins, err = sdk.ParseCoinsNormalized(fundCoins); err != nil {
+			return err
+		}
+
+		if testnetCfg.VotingPeriod, err = cmd.Flags().GetDuration(flagVotingPeriod); err != nil {
+			return err
+		}
+
+		pubKey, err := testnetifyConsensusState(serverCtx.Config, newChainID)
+		if err != nil {
+			return err
+		}
+		if testnetCfg.ValidatorPubKey, err = cryptocodec.FromTmPubKeyInterface(pubKey); err != nil {
+			return err
+		}
+
+		// the app picks its chain ID up from the updated genesis file, set it
+		// explicitly all the same
+		serverCtx.Viper.Set(flags.FlagChainID, newChainID)
+
+		return nil
+	}
+
+	cmd.Flags().StringSlice(flagAccountsToFund, nil, "Comma-separated list of account addresses to fund")
+	cmd.Flags().String(flagFundCoins, "", "Coins each funded account receives (default one million bond tokens)")
+	cmd.Flags().Duration(flagVotingPeriod, time.Minute, "Governance voting period of the testnet")
+
+	return cmd
+}
+
+// testnetifyConsensusState rewrites the CometBFT state and block store of the
+// node so the next block is proposed and signed by the node's validator key
+// alone, on newChainID. It returns the validator public key.
+func testnetifyConsensusState(config *tmcfg.Config, newChainID string) (tmcrypto.PubKey, error) {
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return nil, err
+	}
+	defer blockStoreDB.Close()
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return nil, err
+	}
+	defer stateDB.Close()
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: config.Storage.DiscardABCIResponses})
+
+	state, genDoc, err := node.LoadStateFromDBOrGenesisDocProvider(stateDB, node.DefaultGenesisDocProviderFunc(config))
+	if err != nil {
+		return nil, err
+	}
+
+	height := blockStore.Height()
+	if height == 0 {
+		return nil, errors.New("the node has not committed any block yet")
+	}
+	if state.LastBlockHeight != height {
+		return nil, fmt.Errorf("state height %d does not match block store height %d", state.LastBlockHeight, height)
+	}
+
+	privValidator := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	pubKey := privValidator.Key.PubKey
+	validatorAddress := pubKey.Address()
+
+	// sign the last block on the new chain ID, so it is committed by our
+	// validator alone
+	vote := tmtypes.Vote{
+		Type:             tmproto.PrecommitType,
+		Height:           height,
+		Round:            0,
+		BlockID:          state.LastBlockID,
+		Timestamp:        time.Now().UTC(),
+		ValidatorAddress: validatorAddress,
+		ValidatorIndex:   0,
+	}
+	signature, err := privValidator.Key.PrivKey.Sign(tmtypes.VoteSignBytes(newChainID, vote.ToProto()))
+	if err != nil {
+		return nil, err
+	}
+
+	seenCommit := blockStore.LoadSeenCommit(height)
+	if seenCommit == nil {
+		return nil, fmt.Errorf("no seen commit for height %d", height)
+	}
+	seenCommit.Round = vote.Round
+	seenCommit.BlockID = state.LastBlockID
+	seenCommit.Signatures = []tmtypes.CommitSig{{
+		BlockIDFlag:      tmtypes.BlockIDFlagCommit,
+		ValidatorAddress: validatorAddress,
+		Timestamp:        vote.Timestamp,
+		Signature:        signature,
+	}}
+	if err := blockStore.SaveSeenCommit(height, seenCommit); err != nil {
+		return nil, err
+	}
+
+	newValidator := tmtypes.NewValidator(pubKey, app.TestnetValidatorPower)
+	newValSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{newValidator})
+
+	state.ChainID = newChainID
+	state.Validators = newValSet
+	state.LastValidators = newValSet
+	state.NextValidators = newValSet
+	state.LastHeightValidatorsChanged = height
+	if err := stateStore.Save(state); err != nil {
+		return nil, err
+	}
+
+	// the validators of the last, current and next height are loaded by height
+	// from the state store, overwrite all of them
+	valSetProto, err := newValSet.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range []int64{height - 1, height, height + 1, height + 2} {
+		valInfo, err := (&tmstate.ValidatorsInfo{ValidatorSet: valSetProto, LastHeightChanged: h}).Marshal()
+		if err != nil {
+			return nil, err
+		}
+		if err := stateDB.Set([]byte(fmt.Sprintf("validatorsKey:%v", h)), valInfo); err != nil {
+			return nil, err
+		}
+	}
+
+	// the genesis document is stored in the state DB as well, update both
+	genDoc.ChainID = newChainID
+	genDocBz, err := tmjson.Marshal(genDoc)
+	if err != nil {
+		return nil, err
+	}
+	if err := stateDB.SetSync([]byte("genesisDoc"), genDocBz); err != nil {
+		return nil, err
+	}
+
+	return pubKey, genDoc.SaveAs(config.GenesisFile())
+}
@@ -0,0 +1,26 @@
+// Package ibcdenom resolves the local denom of the tokens of ICS-20 packets,
+// for the middlewares of the transfer stack.
+package ibcdenom
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// SendDenom returns the denom on this chain of the tokens sent with data.
+func SendDenom(data transfertypes.FungibleTokenPacketData) string {
+	return transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+}
+
+// RecvDenom returns the denom on this chain of the tokens received with
+// packet: the unwound denom of tokens returning to this chain, the voucher
+// denom otherwise.
+func RecvDenom(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) string {
+	if transfertypes.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, data.Denom) {
+		prefix := transfertypes.GetDenomPrefix(packet.SourcePort, packet.SourceChannel)
+		return transfertypes.ParseDenomTrace(data.Denom[len(prefix):]).IBCDenom()
+	}
+
+	prefixed := transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, data.Denom)
+	return transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+}
@@ -0,0 +1,36 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/circuit/types"
+)
+
+// CircuitKeeper defines the expected x/circuit keeper.
+type CircuitKeeper interface {
+	CheckMsgs(ctx sdk.Context, gk types.GroupKeeper, msgs []sdk.Msg) error
+}
+
+// CircuitBreakerDecorator rejects txs carrying a message whose circuit is
+// tripped. Messages nested in an authz MsgExec and in group proposals are
+// checked as well. Messages executed by the ICA host do not go through the
+// ante handler and are guarded by the keeper's MsgRouter instead.
+type CircuitBreakerDecorator struct {
+	circuitKeeper CircuitKeeper
+	groupKeeper   types.GroupKeeper
+}
+
+func NewCircuitBreakerDecorator(ck CircuitKeeper, gk types.GroupKeeper) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitKeeper: ck,
+		groupKeeper:   gk,
+	}
+}
+
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := cbd.circuitKeeper.CheckMsgs(ctx, cbd.groupKeeper, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/fatal-fruit/proton/x/circuit/types"
+)
+
+// GetTxCmd returns the transaction commands for the circuit module.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		GetCmdTripCircuitBreaker(),
+		GetCmdResetCircuitBreaker(),
+	)
+
+	return txCmd
+}
+
+// GetCmdTripCircuitBreaker implements a command to disable message types.
+func GetCmdTripCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "trip [msg-type-url]...",
+		Short:   "Disable the given message types, signer must be an authorized address",
+		Example: "protond tx circuit trip /cosmos.bank.v1beta1.MsgSend --from mykey",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdResetCircuitBreaker implements a command to enable message types again.
+func GetCmdResetCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reset [msg-type-url]...",
+		Short:   "Enable the given message types again, signer must be an authorized address",
+		Example: "protond tx circuit reset /cosmos.bank.v1beta1.MsgSend --from mykey",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/circuit/types"
+)
+
+// InitGenesis new circuit genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, msgTypeURL := range data.DisabledTypeUrls {
+		k.DisableMsg(ctx, msgTypeURL)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetDisabledList(ctx))
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/circuit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the circuit module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// DisabledList returns the message type URLs whose circuit is tripped.
+func (k Keeper) DisabledList(c context.Context, _ *types.QueryDisabledListRequest) (*types.QueryDisabledListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDisabledListResponse{DisabledTypeUrls: k.GetDisabledList(ctx)}, nil
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/circuit/types"
+)
+
+// Keeper of the x/circuit store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new x/circuit Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, authority string) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/circuit module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/circuit module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/circuit module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// IsAllowed returns false if the circuit of msgTypeURL is tripped.
+func (k Keeper) IsAllowed(ctx sdk.Context, msgTypeURL string) bool {
+	return !ctx.KVStore(k.storeKey).Has(types.DisabledKey(msgTypeURL))
+}
+
+// DisableMsg trips the circuit of msgTypeURL.
+func (k Keeper) DisableMsg(ctx sdk.Context, msgTypeURL string) {
+	ctx.KVStore(k.storeKey).Set(types.DisabledKey(msgTypeURL), []byte{0x01})
+}
+
+// EnableMsg resets the circuit of msgTypeURL.
+func (k Keeper) EnableMsg(ctx sdk.Context, msgTypeURL string) {
+	ctx.KVStore(k.storeKey).Delete(types.DisabledKey(msgTypeURL))
+}
+
+// GetDisabledList returns the message type URLs whose circuit is tripped.
+func (k Keeper) GetDisabledList(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisabledListPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	disabled := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		disabled = append(disabled, string(iterator.Key()))
+	}
+
+	return disabled
+}
+
+// IsAuthorized returns true if addr may trip and reset circuits.
+func (k Keeper) IsAuthorized(ctx sdk.Context, addr string) bool {
+	return addr == k.authority || k.GetParams(ctx).IsAuthorized(addr)
+}
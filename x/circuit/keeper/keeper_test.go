@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/x/circuit/keeper"
+	"github.com/fatal-fruit/proton/x/circuit/types"
+)
+
+var msgSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func setup(t *testing.T) (*app.ProtonApp, sdk.Context) {
+	t.Helper()
+
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	return protonApp, ctx
+}
+
+func TestTripAndResetCircuitBreaker(t *testing.T) {
+	council := sdk.AccAddress("council_____________").String()
+	stranger := sdk.AccAddress("stranger____________").String()
+
+	testCases := []struct {
+		name      string
+		authority func(k keeper.Keeper) string
+		msgTypes  []string
+		expErr    error
+	}{
+		{"governance may trip", func(k keeper.Keeper) string { return k.GetAuthority() }, []string{msgSendTypeURL}, nil},
+		{"security council may trip", func(keeper.Keeper) string { return council }, []string{msgSendTypeURL}, nil},
+		{"other addresses may not trip", func(keeper.Keeper) string { return stranger }, []string{msgSendTypeURL}, types.ErrUnauthorized},
+		{"circuit msgs cannot be tripped", func(keeper.Keeper) string { return council }, []string{sdk.MsgTypeURL(&types.MsgResetCircuitBreaker{})}, sdkerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			protonApp, ctx := setup(t)
+			k := protonApp.CircuitKeeper
+			msgServer := keeper.NewMsgServerImpl(k)
+			require.NoError(t, k.SetParams(ctx, types.NewParams([]string{council})))
+
+			authority := tc.authority(k)
+			_, err := msgServer.TripCircuitBreaker(sdk.WrapSDKContext(ctx), types.NewMsgTripCircuitBreaker(authority, tc.msgTypes))
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Empty(t, k.GetDisabledList(ctx))
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, k.IsAllowed(ctx, msgSendTypeURL))
+			require.Equal(t, []string{msgSendTypeURL}, k.GetDisabledList(ctx))
+
+			_, err = msgServer.ResetCircuitBreaker(sdk.WrapSDKContext(ctx), types.NewMsgResetCircuitBreaker(authority, tc.msgTypes))
+			require.NoError(t, err)
+			require.True(t, k.IsAllowed(ctx, msgSendTypeURL))
+		})
+	}
+}
+
+func TestMsgRouter(t *testing.T) {
+	protonApp, ctx := setup(t)
+	k := protonApp.CircuitKeeper
+	require.NoError(t, protonApp.BankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+	router := keeper.NewMsgRouter(k, protonApp.GroupKeeper, protonApp.MsgServiceRouter())
+
+	from := sdk.AccAddress("from________________")
+	to := sdk.AccAddress("to__________________")
+	msg := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+
+	k.DisableMsg(ctx, msgSendTypeURL)
+	handler := router.Handler(msg)
+	require.NotNil(t, handler)
+
+	_, err := handler(ctx, msg)
+	require.ErrorIs(t, err, types.ErrCircuitBreakerTripped)
+
+	// authz and x/group route nested msgs themselves, so the router checks them
+	execMsg := authz.NewMsgExec(to, []sdk.Msg{msg})
+	handler = router.Handler(&execMsg)
+	require.NotNil(t, handler)
+	_, err = handler(ctx, &execMsg)
+	require.ErrorIs(t, err, types.ErrCircuitBreakerTripped)
+
+	k.EnableMsg(ctx, msgSendTypeURL)
+	handler = router.Handler(msg)
+	_, err = handler(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// unknown proposals pass the circuit check and are rejected by x/group
+	groupExec := &group.MsgExec{ProposalId: 1, Executor: from.String()}
+	handler = router.Handler(groupExec)
+	require.NotNil(t, handler)
+	_, err = handler(ctx, groupExec)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	require.NotErrorIs(t, err, types.ErrCircuitBreakerTripped)
+}
+
+type mockGroupKeeper struct {
+	proposal *group.Proposal
+	err      error
+}
+
+func (m mockGroupKeeper) Proposal(context.Context, *group.QueryProposalRequest) (*group.QueryProposalResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &group.QueryProposalResponse{Proposal: m.proposal}, nil
+}
+
+func TestCheckMsgs(t *testing.T) {
+	from := sdk.AccAddress("from________________")
+	to := sdk.AccAddress("to__________________")
+	msgSend := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+	execMsg := authz.NewMsgExec(to, []sdk.Msg{msgSend})
+	proposal := &group.Proposal{Id: 1}
+	require.NoError(t, proposal.SetMsgs([]sdk.Msg{msgSend}))
+	queryErr := errors.New("query failed")
+
+	testCases := []struct {
+		name        string
+		groupKeeper types.GroupKeeper
+		msg         sdk.Msg
+		expErr      error
+	}{
+		{"top-level msg", mockGroupKeeper{}, msgSend, types.ErrCircuitBreakerTripped},
+		{"msg nested in authz exec", mockGroupKeeper{}, &execMsg, types.ErrCircuitBreakerTripped},
+		{"msg nested in group proposal exec", mockGroupKeeper{proposal: proposal}, &group.MsgExec{ProposalId: 1}, types.ErrCircuitBreakerTripped},
+		{"msg nested in group vote with try exec", mockGroupKeeper{proposal: proposal}, &group.MsgVote{ProposalId: 1, Exec: group.Exec_EXEC_TRY}, types.ErrCircuitBreakerTripped},
+		{"group vote without exec", mockGroupKeeper{proposal: proposal}, &group.MsgVote{ProposalId: 1}, nil},
+		{"unknown group proposal", mockGroupKeeper{err: errorsmod.Wrap(sdkerrors.ErrNotFound, "load proposal")}, &group.MsgExec{ProposalId: 1}, nil},
+		{"failing group proposal query", mockGroupKeeper{err: queryErr}, &group.MsgExec{ProposalId: 1}, queryErr},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			protonApp, ctx := setup(t)
+			k := protonApp.CircuitKeeper
+			k.DisableMsg(ctx, msgSendTypeURL)
+
+			err := k.CheckMsgs(ctx, tc.groupKeeper, []sdk.Msg{tc.msg})
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/fatal-fruit/proton/x/circuit/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/circuit MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// TripCircuitBreaker disables the given message type URLs.
+func (ms msgServer) TripCircuitBreaker(goCtx context.Context, req *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !ms.IsAuthorized(ctx, req.Authority) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s may not trip circuit breakers", req.Authority)
+	}
+
+	for _, msgTypeURL := range req.MsgTypeUrls {
+		if types.IsProtectedMsgTypeURL(msgTypeURL) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "circuit of %s cannot be tripped", msgTypeURL)
+		}
+
+		ms.DisableMsg(ctx, msgTypeURL)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTripCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+			),
+		)
+	}
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker enables the given message type URLs again.
+func (ms msgServer) ResetCircuitBreaker(goCtx context.Context, req *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !ms.IsAuthorized(ctx, req.Authority) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s may not reset circuit breakers", req.Authority)
+	}
+
+	for _, msgTypeURL := range req.MsgTypeUrls {
+		ms.EnableMsg(ctx, msgTypeURL)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeResetCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+			),
+		)
+	}
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	"github.com/fatal-fruit/proton/x/circuit/types"
+)
+
+// CheckMsgs returns an error if the circuit of any of msgs is tripped.
+// Messages nested in an authz MsgExec and in group proposals are checked as
+// well, since x/authz and x/group route them without going through the ante
+// handler or a MsgRouter.
+func (k Keeper) CheckMsgs(ctx sdk.Context, gk types.GroupKeeper, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		if !k.IsAllowed(ctx, msgTypeURL) {
+			return errorsmod.Wrapf(types.ErrCircuitBreakerTripped, "%s is disabled", msgTypeURL)
+		}
+
+		nested, err := innerMsgs(ctx, gk, msg)
+		if err != nil {
+			return err
+		}
+		if err := k.CheckMsgs(ctx, gk, nested); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// innerMsgs returns the messages msg may execute on behalf of its signers.
+func innerMsgs(ctx sdk.Context, gk types.GroupKeeper, msg sdk.Msg) ([]sdk.Msg, error) {
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		return msg.GetMessages()
+	case *group.MsgSubmitProposal:
+		return msg.GetMsgs()
+	case *group.MsgExec:
+		return proposalMsgs(ctx, gk, msg.ProposalId)
+	case *group.MsgVote:
+		if msg.Exec != group.Exec_EXEC_TRY {
+			return nil, nil
+		}
+		return proposalMsgs(ctx, gk, msg.ProposalId)
+	default:
+		return nil, nil
+	}
+}
+
+func proposalMsgs(ctx sdk.Context, gk types.GroupKeeper, proposalID uint64) ([]sdk.Msg, error) {
+	res, err := gk.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	if errors.Is(err, sdkerrors.ErrNotFound) {
+		// unknown proposals are rejected by x/group itself
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Proposal.GetMsgs()
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/circuit/types"
+)
+
+// MessageRouter defines the router interface used by modules which execute
+// messages outside of the ante handler, such as the ICA host.
+type MessageRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// MsgRouter wraps a MessageRouter so that messages whose circuit is tripped
+// are rejected before they reach their handler, including the messages nested
+// in an authz MsgExec or a group proposal.
+type MsgRouter struct {
+	keeper      Keeper
+	groupKeeper types.GroupKeeper
+	router      MessageRouter
+}
+
+// NewMsgRouter returns a MsgRouter checking circuits before routing to router.
+func NewMsgRouter(k Keeper, gk types.GroupKeeper, router MessageRouter) MsgRouter {
+	return MsgRouter{
+		keeper:      k,
+		groupKeeper: gk,
+		router:      router,
+	}
+}
+
+// Handler returns the handler of msg, guarded by its circuit breaker.
+func (r MsgRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	handler := r.router.Handler(msg)
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if err := r.keeper.CheckMsgs(ctx, r.groupKeeper, []sdk.Msg{msg}); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, msg)
+	}
+}
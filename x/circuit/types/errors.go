@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrCircuitBreakerTripped = errorsmod.Register(ModuleName, 2, "circuit breaker tripped")
+	ErrUnauthorized          = errorsmod.Register(ModuleName, 3, "unauthorized circuit breaker operation")
+)
@@ -0,0 +1,10 @@
+package types
+
+// x/circuit module event types
+const (
+	EventTypeTripCircuitBreaker  = "trip_circuit_breaker"
+	EventTypeResetCircuitBreaker = "reset_circuit_breaker"
+
+	AttributeKeyAuthority  = "authority"
+	AttributeKeyMsgTypeURL = "msg_type_url"
+)
@@ -0,0 +1,13 @@
+package types
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// GroupKeeper defines the expected x/group keeper, used to look up the
+// messages of a proposal that is executed.
+type GroupKeeper interface {
+	Proposal(goCtx context.Context, request *group.QueryProposalRequest) (*group.QueryProposalResponse, error)
+}
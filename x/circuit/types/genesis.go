@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, disabledTypeURLs []string) *GenesisState {
+	return &GenesisState{
+		Params:           params,
+		DisabledTypeUrls: disabledTypeURLs,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []string{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, msgTypeURL := range data.DisabledTypeUrls {
+		if IsProtectedMsgTypeURL(msgTypeURL) {
+			return fmt.Errorf("circuit of %s cannot be tripped", msgTypeURL)
+		}
+	}
+
+	if len(data.DisabledTypeUrls) > 0 {
+		return validateMsgTypeURLs(data.DisabledTypeUrls)
+	}
+
+	return nil
+}
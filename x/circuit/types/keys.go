@@ -0,0 +1,21 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "circuit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the store key of the x/circuit parameters.
+	ParamsKey = []byte{0x01}
+	// DisabledListPrefix is the store prefix of the disabled message type URLs.
+	DisabledListPrefix = []byte{0x02}
+)
+
+// DisabledKey returns the store key marking msgTypeURL as disabled.
+func DisabledKey(msgTypeURL string) []byte {
+	return append(DisabledListPrefix, []byte(msgTypeURL)...)
+}
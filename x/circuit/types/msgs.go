@@ -0,0 +1,122 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgTripCircuitBreaker{}
+	_ sdk.Msg = &MsgResetCircuitBreaker{}
+)
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker instance.
+func NewMsgTripCircuitBreaker(authority string, msgTypeURLs []string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgTripCircuitBreaker message.
+func (m *MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	for _, msgTypeURL := range m.MsgTypeUrls {
+		if IsProtectedMsgTypeURL(msgTypeURL) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "circuit of %s cannot be tripped", msgTypeURL)
+		}
+	}
+
+	return validateMsgTypeURLs(m.MsgTypeUrls)
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker instance.
+func NewMsgResetCircuitBreaker(authority string, msgTypeURLs []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgResetCircuitBreaker message.
+func (m *MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return validateMsgTypeURLs(m.MsgTypeUrls)
+}
+
+// IsProtectedMsgTypeURL returns true for the x/circuit messages, which can
+// never be disabled so that a tripped circuit can always be reset.
+func IsProtectedMsgTypeURL(msgTypeURL string) bool {
+	switch msgTypeURL {
+	case sdk.MsgTypeURL(&MsgUpdateParams{}),
+		sdk.MsgTypeURL(&MsgTripCircuitBreaker{}),
+		sdk.MsgTypeURL(&MsgResetCircuitBreaker{}):
+		return true
+	default:
+		return false
+	}
+}
+
+func validateMsgTypeURLs(msgTypeURLs []string) error {
+	if len(msgTypeURLs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg type urls cannot be empty")
+	}
+
+	for _, msgTypeURL := range msgTypeURLs {
+		if msgTypeURL == "" || msgTypeURL[0] != '/' {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid msg type url %q", msgTypeURL)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params instance.
+func NewParams(authorizedAddresses []string) Params {
+	return Params{
+		AuthorizedAddresses: authorizedAddresses,
+	}
+}
+
+// DefaultParams returns the default x/circuit parameters. Only the module
+// authority may trip circuits until a security council is authorized.
+func DefaultParams() Params {
+	return NewParams([]string{})
+}
+
+// Validate performs a stateless validation of the parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.AuthorizedAddresses))
+	for _, addr := range p.AuthorizedAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid authorized address %s: %w", addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate authorized address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// IsAuthorized returns true if addr is an authorized address.
+func (p Params) IsAuthorized(addr string) bool {
+	for _, a := range p.AuthorizedAddresses {
+		if a == addr {
+			return true
+		}
+	}
+
+	return false
+}
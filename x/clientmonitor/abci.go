@@ -0,0 +1,22 @@
+package clientmonitor
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/clientmonitor/keeper"
+	"github.com/fatal-fruit/proton/x/clientmonitor/types"
+)
+
+// EndBlocker checks the expiry of the IBC clients every check interval.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if uint64(ctx.BlockHeight())%k.GetParams(ctx).CheckInterval != 0 {
+		return
+	}
+
+	k.CheckClients(ctx)
+}
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/fatal-fruit/proton/x/clientmonitor/types"
+)
+
+// FlagStatus filters the client expiries by status.
+const FlagStatus = "status"
+
+// GetQueryCmd returns the cli query commands for the clientmonitor module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the clientmonitor module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryClientExpiry(),
+		GetCmdQueryClientExpiries(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current clientmonitor
+// parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current client monitor parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClientExpiry implements a command to return the expiry of a
+// 07-tendermint client.
+func GetCmdQueryClientExpiry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "expiry [client-id]",
+		Short:   "Query the expiry of a 07-tendermint client",
+		Example: "protond query clientmonitor expiry 07-tendermint-0",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClientExpiry(cmd.Context(), &types.QueryClientExpiryRequest{ClientId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Expiry)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClientExpiries implements a command to return the expiries of
+// all 07-tendermint clients.
+func GetCmdQueryClientExpiries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "expiries",
+		Short:   "Query the expiries of all 07-tendermint clients",
+		Example: "protond query clientmonitor expiries --status expiring",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			statusFlag, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			status, err := parseClientStatus(statusFlag)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClientExpiries(cmd.Context(), &types.QueryClientExpiriesRequest{Status: status})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "Only list the clients of this status: active, expiring, expired or frozen")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseClientStatus parses a client status, given by its short name or its
+// full enum name.
+func parseClientStatus(s string) (types.ClientStatus, error) {
+	if s == "" {
+		return types.ClientStatusUnspecified, nil
+	}
+
+	name := strings.ToUpper(s)
+	if !strings.HasPrefix(name, "CLIENT_STATUS_") {
+		name = "CLIENT_STATUS_" + name
+	}
+	status, ok := types.ClientStatus_value[name]
+	if !ok || status == int32(types.ClientStatusUnspecified) {
+		return types.ClientStatusUnspecified, fmt.Errorf("invalid client status %q", s)
+	}
+
+	return types.ClientStatus(status), nil
+}
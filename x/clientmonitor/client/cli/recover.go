@@ -0,0 +1,212 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/spf13/cobra"
+
+	"github.com/fatal-fruit/proton/x/clientmonitor/types"
+)
+
+const (
+	FlagDeposit = "deposit"
+	FlagTitle   = "title"
+	FlagSummary = "summary"
+)
+
+// proposal is the proposal file read by the gov submit-proposal command.
+type proposal struct {
+	Messages []json.RawMessage `json:"messages,omitempty"`
+	Metadata string            `json:"metadata"`
+	Deposit  string            `json:"deposit"`
+	Title    string            `json:"title"`
+	Summary  string            `json:"summary"`
+}
+
+// NewIBCRecoverCmd returns a command drafting the governance proposal which
+// recovers an expired or frozen IBC client.
+func NewIBCRecoverCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-recover [subject-client-id]",
+		Short: "Draft a governance proposal recovering an expired or frozen IBC client",
+		Long: `Find the active 07-tendermint client furthest ahead which tracks the chain of the
+expired or frozen subject client with the same parameters, and print the
+governance proposal replacing the subject client with it. The deposit defaults
+to the minimum deposit. Submit the proposal with "tx gov submit-proposal".`,
+		Example: "protond tx ibc-recover 07-tendermint-0 > proposal.json",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := clienttypes.NewQueryClient(clientCtx)
+			subjectID := args[0]
+
+			res, err := queryClient.ClientState(cmd.Context(), &clienttypes.QueryClientStateRequest{ClientId: subjectID})
+			if err != nil {
+				return err
+			}
+			var clientState ibcexported.ClientState
+			if err := clientCtx.InterfaceRegistry.UnpackAny(res.ClientState, &clientState); err != nil {
+				return err
+			}
+			subject, ok := clientState.(*ibctm.ClientState)
+			if !ok {
+				return fmt.Errorf("client %s is a %s client, only %s clients can be recovered", subjectID, clientState.ClientType(), ibcexported.Tendermint)
+			}
+
+			status, err := clientStatus(cmd.Context(), queryClient, subjectID)
+			if err != nil {
+				return err
+			}
+			if status == ibcexported.Active {
+				return fmt.Errorf("client %s is active and does not need to be recovered", subjectID)
+			}
+
+			candidates, err := substituteCandidates(cmd.Context(), clientCtx, queryClient, subjectID, subject)
+			if err != nil {
+				return err
+			}
+			substituteID, found := types.FindSubstitute(subject, candidates)
+			if !found {
+				return fmt.Errorf("no active client tracking %s with the parameters of %s is ahead of it, create one first", subject.ChainId, subjectID)
+			}
+
+			deposit, err := cmd.Flags().GetString(FlagDeposit)
+			if err != nil {
+				return err
+			}
+			if deposit == "" {
+				if deposit, err = minDeposit(cmd.Context(), clientCtx); err != nil {
+					return err
+				}
+			}
+
+			title, err := cmd.Flags().GetString(FlagTitle)
+			if err != nil {
+				return err
+			}
+			if title == "" {
+				title = fmt.Sprintf("Recover IBC client %s", subjectID)
+			}
+			summary, err := cmd.Flags().GetString(FlagSummary)
+			if err != nil {
+				return err
+			}
+			if summary == "" {
+				summary = fmt.Sprintf("Replace the %s client %s of %s with the active client %s.", strings.ToLower(status.String()), subjectID, subject.ChainId, substituteID)
+			}
+
+			content := clienttypes.NewClientUpdateProposal(title, summary, subjectID, substituteID)
+			msg, err := govv1.NewLegacyContent(content, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+			if err != nil {
+				return err
+			}
+			msgJSON, err := clientCtx.Codec.MarshalInterfaceJSON(msg)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(proposal{
+				Messages: []json.RawMessage{msgJSON},
+				Deposit:  deposit,
+				Title:    title,
+				Summary:  summary,
+			}, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().String(FlagDeposit, "", "Deposit of the proposal, defaults to the minimum deposit")
+	cmd.Flags().String(FlagTitle, "", "Title of the proposal")
+	cmd.Flags().String(FlagSummary, "", "Summary of the proposal")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// clientStatus returns the status of clientID.
+func clientStatus(ctx context.Context, queryClient clienttypes.QueryClient, clientID string) (ibcexported.Status, error) {
+	res, err := queryClient.ClientStatus(ctx, &clienttypes.QueryClientStatusRequest{ClientId: clientID})
+	if err != nil {
+		return ibcexported.Unknown, err
+	}
+
+	return ibcexported.Status(res.Status), nil
+}
+
+// substituteCandidates returns the active 07-tendermint clients which may
+// replace the subject client subjectID.
+func substituteCandidates(ctx context.Context, clientCtx client.Context, queryClient clienttypes.QueryClient, subjectID string, subject *ibctm.ClientState) (map[string]*ibctm.ClientState, error) {
+	clients := make(map[string]*ibctm.ClientState)
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.ClientStates(ctx, &clienttypes.QueryClientStatesRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, identified := range res.ClientStates {
+			if identified.ClientId == subjectID {
+				continue
+			}
+
+			var clientState ibcexported.ClientState
+			if err := clientCtx.InterfaceRegistry.UnpackAny(identified.ClientState, &clientState); err != nil {
+				return nil, err
+			}
+			tmClientState, ok := clientState.(*ibctm.ClientState)
+			if !ok || !types.IsSubstitute(subject, tmClientState) {
+				continue
+			}
+
+			status, err := clientStatus(ctx, queryClient, identified.ClientId)
+			if err != nil {
+				return nil, err
+			}
+			if status == ibcexported.Active {
+				clients[identified.ClientId] = tmClientState
+			}
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return clients, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// minDeposit returns the minimum deposit of a proposal.
+func minDeposit(ctx context.Context, clientCtx client.Context) (string, error) {
+	res, err := govv1.NewQueryClient(clientCtx).Params(ctx, &govv1.QueryParamsRequest{ParamsType: govv1.ParamDeposit})
+	if err != nil {
+		return "", err
+	}
+
+	if res.Params != nil {
+		return sdk.NewCoins(res.Params.MinDeposit...).String(), nil
+	}
+	if res.DepositParams != nil { //nolint:staticcheck // SA1019: chains before v0.47 only fill the deprecated deposit params
+		return sdk.NewCoins(res.DepositParams.MinDeposit...).String(), nil //nolint:staticcheck // SA1019
+	}
+
+	return "", fmt.Errorf("the chain returned no deposit params")
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/fatal-fruit/proton/x/clientmonitor/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the clientmonitor module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ClientExpiry returns the expiry of a 07-tendermint client.
+func (k Keeper) ClientExpiry(c context.Context, req *types.QueryClientExpiryRequest) (*types.QueryClientExpiryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	expiry, found := k.GetClientExpiry(ctx, req.ClientId)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrClientNotMonitored, req.ClientId).Error())
+	}
+
+	return &types.QueryClientExpiryResponse{Expiry: expiry}, nil
+}
+
+// ClientExpiries returns the expiries of all 07-tendermint clients, of the
+// requested status if any.
+func (k Keeper) ClientExpiries(c context.Context, req *types.QueryClientExpiriesRequest) (*types.QueryClientExpiriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var expiries []types.ClientExpiry
+	k.IterateClientExpiries(ctx, func(expiry types.ClientExpiry) bool {
+		if req.Status == types.ClientStatusUnspecified || req.Status == expiry.Status {
+			expiries = append(expiries, expiry)
+		}
+		return false
+	})
+
+	return &types.QueryClientExpiriesResponse{Expiries: expiries}, nil
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+
+	"github.com/fatal-fruit/proton/x/clientmonitor/types"
+)
+
+// Keeper of the x/clientmonitor store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeKey     storetypes.StoreKey
+	clientKeeper types.ClientKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new x/clientmonitor Keeper instance.
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, clientKeeper types.ClientKeeper, authority string) Keeper {
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     key,
+		clientKeeper: clientKeeper,
+		authority:    authority,
+	}
+}
+
+// GetAuthority returns the x/clientmonitor module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/clientmonitor module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/clientmonitor module parameters, or the
+// default parameters until the module genesis is initialized.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetClientStatus stores the status of clientID at its last check.
+func (k Keeper) SetClientStatus(ctx sdk.Context, clientID string, status types.ClientStatus) {
+	ctx.KVStore(k.storeKey).Set(types.ClientStatusKey(clientID), []byte{byte(status)})
+}
+
+// GetClientStatus returns the status of clientID at its last check, or
+// ClientStatusUnspecified if it was never checked.
+func (k Keeper) GetClientStatus(ctx sdk.Context, clientID string) types.ClientStatus {
+	bz := ctx.KVStore(k.storeKey).Get(types.ClientStatusKey(clientID))
+	if len(bz) == 0 {
+		return types.ClientStatusUnspecified
+	}
+
+	return types.ClientStatus(bz[0])
+}
+
+// GetAllMonitoredClients returns the statuses of all checked clients.
+func (k Keeper) GetAllMonitoredClients(ctx sdk.Context) []types.MonitoredClient {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ClientStatusPrefix)
+	defer iter.Close()
+
+	var clients []types.MonitoredClient
+	for ; iter.Valid(); iter.Next() {
+		clients = append(clients, types.MonitoredClient{
+			ClientId: string(iter.Key()[len(types.ClientStatusPrefix):]),
+			Status:   types.ClientStatus(iter.Value()[0]),
+		})
+	}
+
+	return clients
+}
+
+// GetClientExpiry returns the expiry of the 07-tendermint client clientID at
+// the current block time.
+func (k Keeper) GetClientExpiry(ctx sdk.Context, clientID string) (types.ClientExpiry, bool) {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return types.ClientExpiry{}, false
+	}
+
+	return k.clientExpiry(ctx, k.GetParams(ctx), clientID, clientState)
+}
+
+// IterateClientExpiries iterates over the expiries of all 07-tendermint
+// clients at the current block time. Iteration stops when cb returns true.
+func (k Keeper) IterateClientExpiries(ctx sdk.Context, cb func(expiry types.ClientExpiry) bool) {
+	params := k.GetParams(ctx)
+	k.clientKeeper.IterateClientStates(ctx, []byte(ibcexported.Tendermint), func(clientID string, clientState ibcexported.ClientState) bool {
+		expiry, ok := k.clientExpiry(ctx, params, clientID, clientState)
+		if !ok {
+			return false
+		}
+
+		return cb(expiry)
+	})
+}
+
+// clientExpiry returns the expiry of clientState, which is only known for
+// 07-tendermint clients with a consensus state at their latest height.
+func (k Keeper) clientExpiry(ctx sdk.Context, params types.Params, clientID string, clientState ibcexported.ClientState) (types.ClientExpiry, bool) {
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return types.ClientExpiry{}, false
+	}
+
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, tmClientState.LatestHeight)
+	if !found {
+		return types.ClientExpiry{}, false
+	}
+	tmConsensusState, ok := consensusState.(*ibctm.ConsensusState)
+	if !ok {
+		return types.ClientExpiry{}, false
+	}
+
+	expiry := types.ClientExpiry{
+		ClientId:       clientID,
+		ChainId:        tmClientState.ChainId,
+		LatestHeight:   tmClientState.LatestHeight,
+		TrustingPeriod: tmClientState.TrustingPeriod,
+		ExpiresAt:      tmConsensusState.Timestamp.Add(tmClientState.TrustingPeriod),
+	}
+
+	switch k.clientKeeper.GetClientStatus(ctx, clientState, clientID) {
+	case ibcexported.Active:
+		warningPeriod := time.Duration(params.WarningThreshold.MulInt64(int64(tmClientState.TrustingPeriod)).TruncateInt64())
+		if expiry.ExpiresAt.Sub(ctx.BlockTime()) <= warningPeriod {
+			expiry.Status = types.ClientStatusExpiring
+		} else {
+			expiry.Status = types.ClientStatusActive
+		}
+	case ibcexported.Expired:
+		expiry.Status = types.ClientStatusExpired
+	case ibcexported.Frozen:
+		expiry.Status = types.ClientStatusFrozen
+	default:
+		// clients of a type no longer allowed are not monitored
+		return types.ClientExpiry{}, false
+	}
+
+	return expiry, true
+}
+
+// CheckClients checks the expiry of all 07-tendermint clients and emits an
+// event for every client whose status changed since its last check: a client
+// nearing expiry, expired, frozen, or active again once updated or recovered.
+func (k Keeper) CheckClients(ctx sdk.Context) {
+	k.IterateClientExpiries(ctx, func(expiry types.ClientExpiry) bool {
+		previous := k.GetClientStatus(ctx, expiry.ClientId)
+		if previous == expiry.Status {
+			return false
+		}
+		k.SetClientStatus(ctx, expiry.ClientId, expiry.Status)
+
+		// clients seen active for the first time are not worth an event
+		if previous == types.ClientStatusUnspecified && expiry.Status == types.ClientStatusActive {
+			return false
+		}
+
+		remaining := expiry.ExpiresAt.Sub(ctx.BlockTime())
+		if remaining < 0 {
+			remaining = 0
+		}
+
+		if expiry.Status != types.ClientStatusActive {
+			k.Logger(ctx).Info("IBC client needs attention", "client_id", expiry.ClientId, "chain_id", expiry.ChainId, "status", expiry.Status, "expires_at", expiry.ExpiresAt)
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClientStatus,
+				sdk.NewAttribute(types.AttributeKeyClientID, expiry.ClientId),
+				sdk.NewAttribute(types.AttributeKeyChainID, expiry.ChainId),
+				sdk.NewAttribute(types.AttributeKeyStatus, expiry.Status.String()),
+				sdk.NewAttribute(types.AttributeKeyPrevious, previous.String()),
+				sdk.NewAttribute(types.AttributeKeyExpiresAt, expiry.ExpiresAt.UTC().Format(time.RFC3339)),
+				sdk.NewAttribute(types.AttributeKeyRemaining, remaining.String()),
+			),
+		)
+
+		return false
+	})
+}
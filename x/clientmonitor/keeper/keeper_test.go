@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/x/clientmonitor"
+	"github.com/fatal-fruit/proton/x/clientmonitor/types"
+)
+
+func setup(t *testing.T) (*app.ProtonApp, sdk.Context) {
+	t.Helper()
+
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	return protonApp, ctx
+}
+
+func newClientState(chainID string, trustingPeriod time.Duration, latestHeight uint64) *ibctm.ClientState {
+	return ibctm.NewClientState(
+		chainID,
+		ibctm.DefaultTrustLevel,
+		trustingPeriod,
+		21*24*time.Hour,
+		10*time.Second,
+		clienttypes.NewHeight(1, latestHeight),
+		commitmenttypes.GetSDKSpecs(),
+		[]string{"upgrade", "upgradedIBCState"},
+	)
+}
+
+func TestFindSubstitute(t *testing.T) {
+	subject := newClientState("gaia-1", 14*24*time.Hour, 100)
+
+	candidates := map[string]*ibctm.ClientState{
+		// behind the subject
+		"07-tendermint-1": newClientState("gaia-1", 14*24*time.Hour, 90),
+		// the trusting period may differ
+		"07-tendermint-2": newClientState("gaia-1", 7*24*time.Hour, 200),
+		"07-tendermint-3": newClientState("gaia-1", 14*24*time.Hour, 150),
+		// the unbonding period may not
+		"07-tendermint-4": func() *ibctm.ClientState {
+			cs := newClientState("gaia-1", 14*24*time.Hour, 300)
+			cs.UnbondingPeriod = time.Hour
+			return cs
+		}(),
+	}
+	substituteID, found := types.FindSubstitute(subject, candidates)
+	require.True(t, found)
+	require.Equal(t, "07-tendermint-2", substituteID)
+
+	// ties go to the lowest identifier
+	candidates["07-tendermint-0"] = newClientState("gaia-1", 14*24*time.Hour, 200)
+	substituteID, found = types.FindSubstitute(subject, candidates)
+	require.True(t, found)
+	require.Equal(t, "07-tendermint-0", substituteID)
+
+	_, found = types.FindSubstitute(subject, map[string]*ibctm.ClientState{"07-tendermint-1": candidates["07-tendermint-1"]})
+	require.False(t, found)
+}
+
+func TestParamsAndGenesis(t *testing.T) {
+	protonApp, ctx := setup(t)
+	k := protonApp.ClientMonitorKeeper
+
+	// an empty store yields the default parameters, not a zero check interval
+	ctx.KVStore(protonApp.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+	require.NotPanics(t, func() { clientmonitor.EndBlocker(ctx, k) })
+
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+	require.Error(t, k.SetParams(ctx, types.NewParams(sdk.OneDec(), 1)))
+	require.Error(t, k.SetParams(ctx, types.NewParams(sdk.ZeroDec(), 1)))
+	require.Error(t, k.SetParams(ctx, types.NewParams(types.DefaultWarningThreshold, 0)))
+
+	genesis := types.NewGenesisState(types.NewParams(sdk.NewDecWithPrec(1, 1), 10), []types.MonitoredClient{
+		{ClientId: "07-tendermint-0", Status: types.ClientStatusExpiring},
+		{ClientId: "07-tendermint-1", Status: types.ClientStatusExpired},
+	})
+	require.NoError(t, types.ValidateGenesis(*genesis))
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(types.DefaultParams(), []types.MonitoredClient{{ClientId: "07-tendermint-0"}})))
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(types.DefaultParams(), append(genesis.Clients, genesis.Clients[0]))))
+
+	k.InitGenesis(ctx, genesis)
+	require.Equal(t, genesis, k.ExportGenesis(ctx))
+
+	_, err := k.ClientExpiry(sdk.WrapSDKContext(ctx), &types.QueryClientExpiryRequest{ClientId: "07-tendermint-9"})
+	require.Error(t, err)
+}
@@ -0,0 +1,1067 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proton/clientmonitor/v1/clientmonitor.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClientStatus is the expiry status of a 07-tendermint client.
+type ClientStatus int32
+
+const (
+	// CLIENT_STATUS_UNSPECIFIED is the status of unchecked clients.
+	ClientStatusUnspecified ClientStatus = 0
+	// CLIENT_STATUS_ACTIVE is the status of clients far from expiry.
+	ClientStatusActive ClientStatus = 1
+	// CLIENT_STATUS_EXPIRING is the status of active clients within the
+	// warning threshold of their expiry.
+	ClientStatusExpiring ClientStatus = 2
+	// CLIENT_STATUS_EXPIRED is the status of clients past their trusting
+	// period.
+	ClientStatusExpired ClientStatus = 3
+	// CLIENT_STATUS_FROZEN is the status of clients frozen for misbehaviour.
+	ClientStatusFrozen ClientStatus = 4
+)
+
+var ClientStatus_name = map[int32]string{
+	0: "CLIENT_STATUS_UNSPECIFIED",
+	1: "CLIENT_STATUS_ACTIVE",
+	2: "CLIENT_STATUS_EXPIRING",
+	3: "CLIENT_STATUS_EXPIRED",
+	4: "CLIENT_STATUS_FROZEN",
+}
+
+var ClientStatus_value = map[string]int32{
+	"CLIENT_STATUS_UNSPECIFIED": 0,
+	"CLIENT_STATUS_ACTIVE":      1,
+	"CLIENT_STATUS_EXPIRING":    2,
+	"CLIENT_STATUS_EXPIRED":     3,
+	"CLIENT_STATUS_FROZEN":      4,
+}
+
+func (x ClientStatus) String() string {
+	return proto.EnumName(ClientStatus_name, int32(x))
+}
+
+func (ClientStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a74ec28a256b6e57, []int{0}
+}
+
+// Params defines the set of x/clientmonitor parameters.
+type Params struct {
+	// warning_threshold is the share of the trusting period left before expiry
+	// at which a client is reported as expiring.
+	WarningThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=warning_threshold,json=warningThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"warning_threshold"`
+	// check_interval is the number of blocks between two checks of the
+	// clients.
+	CheckInterval uint64 `protobuf:"varint,2,opt,name=check_interval,json=checkInterval,proto3" json:"check_interval,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a74ec28a256b6e57, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetCheckInterval() uint64 {
+	if m != nil {
+		return m.CheckInterval
+	}
+	return 0
+}
+
+// ClientExpiry is the expiry of a 07-tendermint client: the time its latest
+// consensus state leaves the trusting period.
+type ClientExpiry struct {
+	// client_id is the client identifier.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// chain_id is the chain tracked by the client.
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// latest_height is the latest height of the client.
+	LatestHeight types.Height `protobuf:"bytes,3,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height"`
+	// trusting_period is the trusting period of the client.
+	TrustingPeriod time.Duration `protobuf:"bytes,4,opt,name=trusting_period,json=trustingPeriod,proto3,stdduration" json:"trusting_period"`
+	// expires_at is the time the client expires unless it is updated.
+	ExpiresAt time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	// status is the status of the client at the current block time.
+	Status ClientStatus `protobuf:"varint,6,opt,name=status,proto3,enum=proton.clientmonitor.v1.ClientStatus" json:"status,omitempty"`
+}
+
+func (m *ClientExpiry) Reset()         { *m = ClientExpiry{} }
+func (m *ClientExpiry) String() string { return proto.CompactTextString(m) }
+func (*ClientExpiry) ProtoMessage()    {}
+func (*ClientExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a74ec28a256b6e57, []int{1}
+}
+func (m *ClientExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientExpiry.Merge(m, src)
+}
+func (m *ClientExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientExpiry proto.InternalMessageInfo
+
+func (m *ClientExpiry) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientExpiry) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ClientExpiry) GetLatestHeight() types.Height {
+	if m != nil {
+		return m.LatestHeight
+	}
+	return types.Height{}
+}
+
+func (m *ClientExpiry) GetTrustingPeriod() time.Duration {
+	if m != nil {
+		return m.TrustingPeriod
+	}
+	return 0
+}
+
+func (m *ClientExpiry) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+func (m *ClientExpiry) GetStatus() ClientStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ClientStatusUnspecified
+}
+
+// MonitoredClient is the status of a client at its last check.
+type MonitoredClient struct {
+	// client_id is the client identifier.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// status is the status of the client at its last check.
+	Status ClientStatus `protobuf:"varint,2,opt,name=status,proto3,enum=proton.clientmonitor.v1.ClientStatus" json:"status,omitempty"`
+}
+
+func (m *MonitoredClient) Reset()         { *m = MonitoredClient{} }
+func (m *MonitoredClient) String() string { return proto.CompactTextString(m) }
+func (*MonitoredClient) ProtoMessage()    {}
+func (*MonitoredClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a74ec28a256b6e57, []int{2}
+}
+func (m *MonitoredClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MonitoredClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MonitoredClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MonitoredClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitoredClient.Merge(m, src)
+}
+func (m *MonitoredClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MonitoredClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitoredClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitoredClient proto.InternalMessageInfo
+
+func (m *MonitoredClient) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *MonitoredClient) GetStatus() ClientStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ClientStatusUnspecified
+}
+
+func init() {
+	proto.RegisterEnum("proton.clientmonitor.v1.ClientStatus", ClientStatus_name, ClientStatus_value)
+	proto.RegisterType((*Params)(nil), "proton.clientmonitor.v1.Params")
+	proto.RegisterType((*ClientExpiry)(nil), "proton.clientmonitor.v1.ClientExpiry")
+	proto.RegisterType((*MonitoredClient)(nil), "proton.clientmonitor.v1.MonitoredClient")
+}
+
+func init() {
+	proto.RegisterFile("proton/clientmonitor/v1/clientmonitor.proto", fileDescriptor_a74ec28a256b6e57)
+}
+
+var fileDescriptor_a74ec28a256b6e57 = []byte{
+	// 707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x3b, 0x6f, 0xd3, 0x40,
+	0x1c, 0x8f, 0xd3, 0x10, 0x9a, 0xa3, 0x8f, 0xd4, 0x94, 0x36, 0x71, 0x85, 0x63, 0x45, 0x2a, 0x8a,
+	0x8a, 0x6a, 0xd3, 0x96, 0xa9, 0x12, 0x43, 0x1e, 0x2e, 0x35, 0xa2, 0x21, 0x24, 0x29, 0x42, 0x5d,
+	0x2c, 0xc7, 0xbe, 0x38, 0xa7, 0xc6, 0xbe, 0xc8, 0xbe, 0x84, 0x96, 0x4f, 0x80, 0x32, 0x75, 0x64,
+	0x09, 0x0b, 0x0b, 0x63, 0x07, 0xbe, 0x01, 0x4b, 0xc7, 0x8a, 0x09, 0x31, 0x14, 0xd4, 0x0e, 0xdd,
+	0xf8, 0x0c, 0x28, 0xbe, 0x4b, 0x9b, 0xf4, 0xc1, 0xc0, 0x92, 0xf8, 0xfe, 0xbf, 0xc7, 0xfd, 0x1f,
+	0xf7, 0x07, 0x8f, 0x5b, 0x1e, 0x26, 0xd8, 0x55, 0xcc, 0x26, 0x82, 0x2e, 0x71, 0xb0, 0x8b, 0x08,
+	0xf6, 0x94, 0xce, 0xca, 0x68, 0x40, 0x0e, 0x58, 0xfc, 0x3c, 0x25, 0xcb, 0xa3, 0x58, 0x67, 0x45,
+	0x98, 0xb5, 0xb1, 0x8d, 0x03, 0x50, 0xe9, 0x7f, 0x51, 0xba, 0x30, 0x63, 0x38, 0xc8, 0xc5, 0x4a,
+	0xf0, 0xcb, 0x42, 0x49, 0x13, 0xfb, 0x0e, 0xf6, 0x75, 0xca, 0xa5, 0x07, 0x06, 0x89, 0x36, 0xc6,
+	0x76, 0x13, 0x2a, 0xc1, 0xa9, 0xd6, 0xae, 0x2b, 0x56, 0xdb, 0x33, 0x08, 0xc2, 0x2e, 0xc3, 0x53,
+	0x57, 0x71, 0x82, 0x1c, 0xe8, 0x13, 0xc3, 0x69, 0x0d, 0x08, 0xa8, 0x66, 0x2a, 0x26, 0xf6, 0x20,
+	0xcb, 0xfd, 0xb2, 0x0a, 0x4a, 0x48, 0x7f, 0xe3, 0x40, 0xb4, 0x64, 0x78, 0x86, 0xe3, 0xf3, 0x2e,
+	0x98, 0x79, 0x67, 0x78, 0x2e, 0x72, 0x6d, 0x9d, 0x34, 0x3c, 0xe8, 0x37, 0x70, 0xd3, 0x4a, 0x70,
+	0x12, 0x97, 0x89, 0xe5, 0xb2, 0x47, 0x27, 0xa9, 0xd0, 0xcf, 0x93, 0xd4, 0x23, 0x1b, 0x91, 0x46,
+	0xbb, 0x26, 0x9b, 0xd8, 0x61, 0x89, 0xb2, 0xbf, 0x65, 0xdf, 0xda, 0x55, 0xc8, 0x7e, 0x0b, 0xfa,
+	0x72, 0x01, 0x9a, 0xdf, 0xbf, 0x2e, 0x03, 0x56, 0x47, 0x01, 0x9a, 0x5f, 0xce, 0x0f, 0x97, 0xb8,
+	0x72, 0x9c, 0x79, 0x57, 0x07, 0xd6, 0xfc, 0x22, 0x98, 0x32, 0x1b, 0xd0, 0xdc, 0xd5, 0x91, 0x4b,
+	0xa0, 0xd7, 0x31, 0x9a, 0x89, 0xb0, 0xc4, 0x65, 0x22, 0xe5, 0xc9, 0x20, 0xaa, 0xb1, 0xe0, 0x7a,
+	0xba, 0x7b, 0x7e, 0xb8, 0xf4, 0x90, 0x8d, 0x64, 0xef, 0xca, 0x50, 0x68, 0xea, 0xe9, 0x3f, 0x61,
+	0x30, 0x91, 0x0f, 0x00, 0x75, 0xaf, 0x85, 0xbc, 0x7d, 0x7e, 0x01, 0xc4, 0x28, 0x51, 0x47, 0xac,
+	0x86, 0xf2, 0x38, 0x0d, 0x68, 0x16, 0x9f, 0x04, 0xe3, 0x66, 0xc3, 0x40, 0x6e, 0x1f, 0x0b, 0x07,
+	0xd8, 0xdd, 0xe0, 0xac, 0x59, 0xfc, 0x0b, 0x30, 0xd9, 0x34, 0x08, 0xf4, 0x89, 0xde, 0x80, 0xc8,
+	0x6e, 0x90, 0xc4, 0x98, 0xc4, 0x65, 0xee, 0xad, 0x0a, 0x32, 0xaa, 0x99, 0x72, 0xbf, 0x8f, 0x6c,
+	0xce, 0x72, 0x67, 0x45, 0xde, 0x0c, 0x18, 0xb9, 0x58, 0xbf, 0x37, 0xb4, 0xc6, 0x09, 0xaa, 0xa5,
+	0x00, 0xff, 0x1a, 0x4c, 0x13, 0xaf, 0xed, 0x93, 0x7e, 0x43, 0x5b, 0xd0, 0x43, 0xd8, 0x4a, 0x44,
+	0x02, 0xb7, 0xa4, 0x4c, 0xc7, 0x26, 0x0f, 0xc6, 0x26, 0x17, 0xd8, 0x58, 0x73, 0x93, 0x7d, 0xb3,
+	0x8f, 0xbf, 0x52, 0x1c, 0x35, 0x9c, 0x1a, 0x18, 0x94, 0x02, 0x3d, 0xbf, 0x09, 0x00, 0xec, 0x17,
+	0x08, 0x7d, 0xdd, 0x20, 0x89, 0x3b, 0x2c, 0xb7, 0xab, 0x6e, 0xd5, 0xc1, 0x23, 0xa0, 0x76, 0x07,
+	0x17, 0x76, 0x31, 0x26, 0xce, 0x12, 0xfe, 0x19, 0x88, 0xfa, 0xc4, 0x20, 0x6d, 0x3f, 0x11, 0x95,
+	0xb8, 0xcc, 0xd4, 0xea, 0xa2, 0x7c, 0xcb, 0x3b, 0x96, 0x69, 0x5f, 0x2b, 0x01, 0xb9, 0xcc, 0x44,
+	0x69, 0x07, 0x4c, 0x6f, 0x51, 0x0a, 0xb4, 0x28, 0xe1, 0xdf, 0x2d, 0xbf, 0xbc, 0x2e, 0xfc, 0x1f,
+	0xd7, 0x2d, 0x7d, 0xba, 0x98, 0x2f, 0x05, 0xf8, 0x75, 0x90, 0xcc, 0xbf, 0xd4, 0xd4, 0x62, 0x55,
+	0xaf, 0x54, 0xb3, 0xd5, 0xed, 0x8a, 0xbe, 0x5d, 0xac, 0x94, 0xd4, 0xbc, 0xb6, 0xa1, 0xa9, 0x85,
+	0x78, 0x48, 0x58, 0xe8, 0xf6, 0xa4, 0xf9, 0x61, 0xc1, 0xb6, 0xeb, 0xb7, 0xa0, 0x89, 0xea, 0x08,
+	0x5a, 0xfc, 0x13, 0x30, 0x3b, 0xaa, 0xcd, 0xe6, 0xab, 0xda, 0x1b, 0x35, 0xce, 0x09, 0x73, 0xdd,
+	0x9e, 0xc4, 0x0f, 0xcb, 0xb2, 0x26, 0x41, 0x1d, 0xc8, 0x3f, 0x05, 0x73, 0xa3, 0x0a, 0xf5, 0x6d,
+	0x49, 0x2b, 0x6b, 0xc5, 0xe7, 0xf1, 0xb0, 0x90, 0xe8, 0xf6, 0xa4, 0xd9, 0x61, 0x4d, 0xf0, 0x02,
+	0x91, 0x6b, 0xf3, 0xab, 0xe0, 0xc1, 0x0d, 0x2a, 0xb5, 0x10, 0x1f, 0x13, 0xe6, 0xbb, 0x3d, 0xe9,
+	0xfe, 0x35, 0xd1, 0x4d, 0xb9, 0x6d, 0x94, 0x5f, 0xed, 0xa8, 0xc5, 0x78, 0xe4, 0x7a, 0x6e, 0x1b,
+	0x1e, 0x7e, 0x0f, 0x5d, 0x21, 0xf2, 0xe1, 0xb3, 0x18, 0xca, 0x6d, 0x1d, 0x9d, 0x8a, 0xdc, 0xf1,
+	0xa9, 0xc8, 0xfd, 0x3e, 0x15, 0xb9, 0x83, 0x33, 0x31, 0x74, 0x7c, 0x26, 0x86, 0x7e, 0x9c, 0x89,
+	0xa1, 0x9d, 0xb5, 0xa1, 0x95, 0xad, 0x1b, 0xc4, 0x68, 0x2e, 0xd7, 0xbd, 0x36, 0x22, 0xca, 0x2d,
+	0x0b, 0x15, 0xec, 0x70, 0x2d, 0x1a, 0xa0, 0x6b, 0x7f, 0x07, 0x00, 0xa6, 0x27, 0x2e, 0x90, 0x0a,
+	0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckInterval != 0 {
+		i = encodeVarintClientmonitor(dAtA, i, uint64(m.CheckInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.WarningThreshold.Size()
+		i -= size
+		if _, err := m.WarningThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClientmonitor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintClientmonitor(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintClientmonitor(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintClientmonitor(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.LatestHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClientmonitor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintClientmonitor(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintClientmonitor(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MonitoredClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MonitoredClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MonitoredClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintClientmonitor(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintClientmonitor(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClientmonitor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClientmonitor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WarningThreshold.Size()
+	n += 1 + l + sovClientmonitor(uint64(l))
+	if m.CheckInterval != 0 {
+		n += 1 + sovClientmonitor(uint64(m.CheckInterval))
+	}
+	return n
+}
+
+func (m *ClientExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovClientmonitor(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovClientmonitor(uint64(l))
+	}
+	l = m.LatestHeight.Size()
+	n += 1 + l + sovClientmonitor(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod)
+	n += 1 + l + sovClientmonitor(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovClientmonitor(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovClientmonitor(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MonitoredClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovClientmonitor(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovClientmonitor(uint64(m.Status))
+	}
+	return n
+}
+
+func sovClientmonitor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClientmonitor(x uint64) (n int) {
+	return sovClientmonitor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClientmonitor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WarningThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckInterval", wireType)
+			}
+			m.CheckInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClientmonitor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClientmonitor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TrustingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ClientStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClientmonitor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MonitoredClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClientmonitor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonitoredClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonitoredClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ClientStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClientmonitor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClientmonitor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClientmonitor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClientmonitor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClientmonitor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClientmonitor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClientmonitor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClientmonitor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClientmonitor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClientmonitor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClientmonitor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClientmonitor = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,10 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/clientmonitor module sentinel errors
+var (
+	ErrClientNotMonitored = errorsmod.Register(ModuleName, 2, "client is not a monitored 07-tendermint client")
+)
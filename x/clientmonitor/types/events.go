@@ -0,0 +1,13 @@
+package types
+
+// x/clientmonitor module event types
+const (
+	EventTypeClientStatus = "ibc_client_status"
+
+	AttributeKeyClientID  = "client_id"
+	AttributeKeyChainID   = "chain_id"
+	AttributeKeyStatus    = "status"
+	AttributeKeyPrevious  = "previous_status"
+	AttributeKeyExpiresAt = "expires_at"
+	AttributeKeyRemaining = "remaining"
+)
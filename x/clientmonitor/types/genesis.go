@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, clients []MonitoredClient) *GenesisState {
+	return &GenesisState{
+		Params:  params,
+		Clients: clients,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []MonitoredClient{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.Clients))
+	for _, client := range data.Clients {
+		if err := host.ClientIdentifierValidator(client.ClientId); err != nil {
+			return fmt.Errorf("invalid monitored client: %w", err)
+		}
+		if _, ok := ClientStatus_name[int32(client.Status)]; !ok || client.Status == ClientStatusUnspecified {
+			return fmt.Errorf("invalid status of client %s: %s", client.ClientId, client.Status)
+		}
+		if seen[client.ClientId] {
+			return fmt.Errorf("duplicate monitored client %s", client.ClientId)
+		}
+		seen[client.ClientId] = true
+	}
+
+	return nil
+}
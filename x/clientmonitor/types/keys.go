@@ -0,0 +1,22 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "clientmonitor"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the store key of the x/clientmonitor parameters.
+	ParamsKey = []byte{0x01}
+	// ClientStatusPrefix is the store prefix of the statuses of the clients at
+	// their last check, keyed by client.
+	ClientStatusPrefix = []byte{0x02}
+)
+
+// ClientStatusKey returns the store key of the status of clientID.
+func ClientStatusKey(clientID string) []byte {
+	return append(ClientStatusPrefix, []byte(clientID)...)
+}
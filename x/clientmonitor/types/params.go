@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultCheckInterval checks the clients every 100 blocks.
+	DefaultCheckInterval uint64 = 100
+)
+
+var (
+	// DefaultWarningThreshold reports clients as expiring within the last
+	// quarter of their trusting period.
+	DefaultWarningThreshold = sdk.NewDecWithPrec(25, 2)
+)
+
+// NewParams creates a new Params instance.
+func NewParams(warningThreshold sdk.Dec, checkInterval uint64) Params {
+	return Params{
+		WarningThreshold: warningThreshold,
+		CheckInterval:    checkInterval,
+	}
+}
+
+// DefaultParams returns the default x/clientmonitor parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultWarningThreshold, DefaultCheckInterval)
+}
+
+// Validate performs a stateless validation of the parameters.
+func (p Params) Validate() error {
+	if p.WarningThreshold.IsNil() || !p.WarningThreshold.IsPositive() || p.WarningThreshold.GTE(sdk.OneDec()) {
+		return fmt.Errorf("warning threshold must be within (0, 1): %s", p.WarningThreshold)
+	}
+	if p.CheckInterval == 0 {
+		return fmt.Errorf("check interval cannot be zero")
+	}
+
+	return nil
+}
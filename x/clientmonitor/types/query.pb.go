@@ -0,0 +1,1316 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proton/clientmonitor/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4735a7a03896aeb9, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4735a7a03896aeb9, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryClientExpiryRequest is the request type for the Query/ClientExpiry RPC
+// method.
+type QueryClientExpiryRequest struct {
+	// client_id is the client identifier.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryClientExpiryRequest) Reset()         { *m = QueryClientExpiryRequest{} }
+func (m *QueryClientExpiryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientExpiryRequest) ProtoMessage()    {}
+func (*QueryClientExpiryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4735a7a03896aeb9, []int{2}
+}
+func (m *QueryClientExpiryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientExpiryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientExpiryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientExpiryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientExpiryRequest.Merge(m, src)
+}
+func (m *QueryClientExpiryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientExpiryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientExpiryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientExpiryRequest proto.InternalMessageInfo
+
+func (m *QueryClientExpiryRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryClientExpiryResponse is the response type for the Query/ClientExpiry
+// RPC method.
+type QueryClientExpiryResponse struct {
+	// expiry is the expiry of the client.
+	Expiry ClientExpiry `protobuf:"bytes,1,opt,name=expiry,proto3" json:"expiry"`
+}
+
+func (m *QueryClientExpiryResponse) Reset()         { *m = QueryClientExpiryResponse{} }
+func (m *QueryClientExpiryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientExpiryResponse) ProtoMessage()    {}
+func (*QueryClientExpiryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4735a7a03896aeb9, []int{3}
+}
+func (m *QueryClientExpiryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientExpiryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientExpiryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientExpiryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientExpiryResponse.Merge(m, src)
+}
+func (m *QueryClientExpiryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientExpiryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientExpiryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientExpiryResponse proto.InternalMessageInfo
+
+func (m *QueryClientExpiryResponse) GetExpiry() ClientExpiry {
+	if m != nil {
+		return m.Expiry
+	}
+	return ClientExpiry{}
+}
+
+// QueryClientExpiriesRequest is the request type for the Query/ClientExpiries
+// RPC method.
+type QueryClientExpiriesRequest struct {
+	// status optionally filters the clients by status.
+	Status ClientStatus `protobuf:"varint,1,opt,name=status,proto3,enum=proton.clientmonitor.v1.ClientStatus" json:"status,omitempty"`
+}
+
+func (m *QueryClientExpiriesRequest) Reset()         { *m = QueryClientExpiriesRequest{} }
+func (m *QueryClientExpiriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientExpiriesRequest) ProtoMessage()    {}
+func (*QueryClientExpiriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4735a7a03896aeb9, []int{4}
+}
+func (m *QueryClientExpiriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientExpiriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientExpiriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientExpiriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientExpiriesRequest.Merge(m, src)
+}
+func (m *QueryClientExpiriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientExpiriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientExpiriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientExpiriesRequest proto.InternalMessageInfo
+
+func (m *QueryClientExpiriesRequest) GetStatus() ClientStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ClientStatusUnspecified
+}
+
+// QueryClientExpiriesResponse is the response type for the
+// Query/ClientExpiries RPC method.
+type QueryClientExpiriesResponse struct {
+	// expiries are the expiries of the clients.
+	Expiries []ClientExpiry `protobuf:"bytes,1,rep,name=expiries,proto3" json:"expiries"`
+}
+
+func (m *QueryClientExpiriesResponse) Reset()         { *m = QueryClientExpiriesResponse{} }
+func (m *QueryClientExpiriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientExpiriesResponse) ProtoMessage()    {}
+func (*QueryClientExpiriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4735a7a03896aeb9, []int{5}
+}
+func (m *QueryClientExpiriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientExpiriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientExpiriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientExpiriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientExpiriesResponse.Merge(m, src)
+}
+func (m *QueryClientExpiriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientExpiriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientExpiriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientExpiriesResponse proto.InternalMessageInfo
+
+func (m *QueryClientExpiriesResponse) GetExpiries() []ClientExpiry {
+	if m != nil {
+		return m.Expiries
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "proton.clientmonitor.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "proton.clientmonitor.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryClientExpiryRequest)(nil), "proton.clientmonitor.v1.QueryClientExpiryRequest")
+	proto.RegisterType((*QueryClientExpiryResponse)(nil), "proton.clientmonitor.v1.QueryClientExpiryResponse")
+	proto.RegisterType((*QueryClientExpiriesRequest)(nil), "proton.clientmonitor.v1.QueryClientExpiriesRequest")
+	proto.RegisterType((*QueryClientExpiriesResponse)(nil), "proton.clientmonitor.v1.QueryClientExpiriesResponse")
+}
+
+func init() {
+	proto.RegisterFile("proton/clientmonitor/v1/query.proto", fileDescriptor_4735a7a03896aeb9)
+}
+
+var fileDescriptor_4735a7a03896aeb9 = []byte{
+	// 487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0x77, 0x2c, 0x2e, 0xcd, 0xab, 0x14, 0x1c, 0x0b, 0xc6, 0xad, 0x6c, 0xda, 0x2d, 0xe2,
+	0x9f, 0xda, 0x1d, 0x92, 0x28, 0x9e, 0xbc, 0x44, 0x04, 0x05, 0x05, 0x5d, 0x4f, 0xea, 0x41, 0xa6,
+	0xed, 0x74, 0x1d, 0xcc, 0xce, 0x6c, 0x77, 0x67, 0x4b, 0x83, 0x78, 0xf1, 0xec, 0x41, 0xf0, 0x3b,
+	0x88, 0x07, 0x0f, 0x7e, 0x8c, 0x1e, 0x0b, 0x5e, 0x3c, 0x89, 0x24, 0x82, 0x37, 0x3f, 0x83, 0x74,
+	0x66, 0x52, 0xbb, 0x31, 0x6b, 0x12, 0x2f, 0xcb, 0xf2, 0xbe, 0xef, 0xf3, 0x3c, 0xbf, 0x99, 0x79,
+	0x61, 0x35, 0xcd, 0xa4, 0x92, 0x82, 0x6c, 0x76, 0x39, 0x13, 0x2a, 0x91, 0x82, 0x2b, 0x99, 0x91,
+	0xdd, 0x26, 0xd9, 0x29, 0x58, 0xd6, 0x0b, 0x75, 0x17, 0x9f, 0x33, 0x43, 0x61, 0x69, 0x28, 0xdc,
+	0x6d, 0x7a, 0x8b, 0xb1, 0x8c, 0xa5, 0x6e, 0x92, 0xc3, 0x3f, 0x33, 0xee, 0x5d, 0x88, 0xa5, 0x8c,
+	0xbb, 0x8c, 0xd0, 0x94, 0x13, 0x2a, 0x84, 0x54, 0x54, 0x71, 0x29, 0x72, 0xdb, 0x3d, 0x43, 0x13,
+	0x2e, 0x24, 0xd1, 0x5f, 0x5b, 0x5a, 0xab, 0x82, 0x28, 0x07, 0xea, 0xa9, 0x60, 0x11, 0xf0, 0xa3,
+	0x43, 0xb6, 0x87, 0x34, 0xa3, 0x49, 0x1e, 0xb1, 0x9d, 0x82, 0xe5, 0x2a, 0x78, 0x02, 0x67, 0x4b,
+	0xd5, 0x3c, 0x95, 0x22, 0x67, 0xb8, 0x03, 0x6e, 0xaa, 0x2b, 0x75, 0xb4, 0x8c, 0x2e, 0x9f, 0x6a,
+	0x35, 0xc2, 0x8a, 0xa3, 0x84, 0x46, 0xd8, 0xa9, 0xed, 0x7f, 0x6b, 0x38, 0x1f, 0x7f, 0x7e, 0xbe,
+	0x8a, 0x22, 0xab, 0x0c, 0x6e, 0x42, 0x5d, 0x5b, 0xdf, 0xd6, 0x92, 0x3b, 0x7b, 0x29, 0xcf, 0x7a,
+	0x36, 0x16, 0x2f, 0x41, 0xcd, 0x38, 0x3d, 0xe7, 0x5b, 0x3a, 0xa2, 0x16, 0xcd, 0x9b, 0xc2, 0xbd,
+	0xad, 0x80, 0xc1, 0xf9, 0x31, 0x42, 0x4b, 0x76, 0x17, 0x5c, 0xa6, 0x2b, 0x96, 0xec, 0x62, 0x25,
+	0xd9, 0x71, 0x79, 0x89, 0xcf, 0xe8, 0x83, 0x67, 0xe0, 0x8d, 0xc6, 0x70, 0x36, 0xbc, 0x18, 0x7c,
+	0x0b, 0xdc, 0x5c, 0x51, 0x55, 0x98, 0x1b, 0x58, 0x98, 0x98, 0xf3, 0x58, 0x0f, 0x47, 0x56, 0x14,
+	0xbc, 0x84, 0xa5, 0xb1, 0xe6, 0xf6, 0x14, 0xf7, 0x61, 0x9e, 0xd9, 0x5a, 0x1d, 0x2d, 0xcf, 0xfd,
+	0xd7, 0x39, 0x8e, 0x1c, 0x5a, 0xbf, 0xe6, 0xe0, 0xa4, 0x4e, 0xc3, 0x6f, 0x11, 0xb8, 0xe6, 0x45,
+	0xf0, 0x5a, 0xa5, 0xe1, 0xdf, 0x6b, 0xe0, 0x5d, 0x9b, 0x6e, 0xd8, 0xd0, 0x07, 0x97, 0xde, 0x7c,
+	0xf9, 0xf1, 0xfe, 0xc4, 0x0a, 0x6e, 0x90, 0xaa, 0x05, 0x34, 0x2b, 0x80, 0x3f, 0x21, 0x38, 0x7d,
+	0x1c, 0x1f, 0x37, 0xff, 0x9d, 0x33, 0x66, 0x55, 0xbc, 0xd6, 0x2c, 0x12, 0x0b, 0x78, 0x43, 0x03,
+	0x12, 0xbc, 0x5e, 0x09, 0x38, 0xbc, 0x3b, 0xf2, 0xea, 0x68, 0x0f, 0x5f, 0xe3, 0x0f, 0x08, 0x16,
+	0xca, 0x0f, 0x86, 0xdb, 0x53, 0xa7, 0xff, 0xd9, 0x1d, 0xef, 0xfa, 0x6c, 0x22, 0x0b, 0x7d, 0x45,
+	0x43, 0xaf, 0xe2, 0x95, 0x89, 0xd0, 0x9d, 0x07, 0xfb, 0x7d, 0x1f, 0x1d, 0xf4, 0x7d, 0xf4, 0xbd,
+	0xef, 0xa3, 0x77, 0x03, 0xdf, 0x39, 0x18, 0xf8, 0xce, 0xd7, 0x81, 0xef, 0x3c, 0x6d, 0xc7, 0x5c,
+	0xbd, 0x28, 0x36, 0xc2, 0x4d, 0x99, 0x90, 0x6d, 0xaa, 0x68, 0x77, 0x7d, 0x3b, 0x2b, 0xb8, 0x1a,
+	0x5a, 0xee, 0x8d, 0x98, 0xaa, 0x5e, 0xca, 0xf2, 0x0d, 0x57, 0x77, 0xdb, 0xbf, 0x07, 0x00, 0xbb,
+	0x47, 0x55, 0xfc, 0xd5, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the x/clientmonitor parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ClientExpiry returns the expiry of a 07-tendermint client.
+	ClientExpiry(ctx context.Context, in *QueryClientExpiryRequest, opts ...grpc.CallOption) (*QueryClientExpiryResponse, error)
+	// ClientExpiries returns the expiries of all 07-tendermint clients.
+	ClientExpiries(ctx context.Context, in *QueryClientExpiriesRequest, opts ...grpc.CallOption) (*QueryClientExpiriesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/proton.clientmonitor.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClientExpiry(ctx context.Context, in *QueryClientExpiryRequest, opts ...grpc.CallOption) (*QueryClientExpiryResponse, error) {
+	out := new(QueryClientExpiryResponse)
+	err := c.cc.Invoke(ctx, "/proton.clientmonitor.v1.Query/ClientExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClientExpiries(ctx context.Context, in *QueryClientExpiriesRequest, opts ...grpc.CallOption) (*QueryClientExpiriesResponse, error) {
+	out := new(QueryClientExpiriesResponse)
+	err := c.cc.Invoke(ctx, "/proton.clientmonitor.v1.Query/ClientExpiries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the x/clientmonitor parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ClientExpiry returns the expiry of a 07-tendermint client.
+	ClientExpiry(context.Context, *QueryClientExpiryRequest) (*QueryClientExpiryResponse, error)
+	// ClientExpiries returns the expiries of all 07-tendermint clients.
+	ClientExpiries(context.Context, *QueryClientExpiriesRequest) (*QueryClientExpiriesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ClientExpiry(ctx context.Context, req *QueryClientExpiryRequest) (*QueryClientExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientExpiry not implemented")
+}
+func (*UnimplementedQueryServer) ClientExpiries(ctx context.Context, req *QueryClientExpiriesRequest) (*QueryClientExpiriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientExpiries not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proton.clientmonitor.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClientExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proton.clientmonitor.v1.Query/ClientExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientExpiry(ctx, req.(*QueryClientExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClientExpiries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientExpiriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientExpiries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proton.clientmonitor.v1.Query/ClientExpiries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientExpiries(ctx, req.(*QueryClientExpiriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proton.clientmonitor.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ClientExpiry",
+			Handler:    _Query_ClientExpiry_Handler,
+		},
+		{
+			MethodName: "ClientExpiries",
+			Handler:    _Query_ClientExpiries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proton/clientmonitor/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientExpiryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientExpiryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientExpiryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientExpiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientExpiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientExpiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientExpiriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientExpiriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientExpiriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientExpiriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientExpiriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientExpiriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiries) > 0 {
+		for iNdEx := len(m.Expiries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Expiries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClientExpiryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientExpiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Expiry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClientExpiriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryClientExpiriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Expiries) > 0 {
+		for _, e := range m.Expiries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientExpiryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientExpiryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientExpiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientExpiryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientExpiryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientExpiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientExpiriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientExpiriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientExpiriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ClientStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientExpiriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientExpiriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientExpiriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiries = append(m.Expiries, ClientExpiry{})
+			if err := m.Expiries[len(m.Expiries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,34 @@
+package types
+
+import (
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+)
+
+// IsSubstitute returns true if substitute may replace subject in a client
+// update proposal: both clients track the chain with the same parameters, up
+// to their trusting period, and substitute is ahead of subject.
+func IsSubstitute(subject, substitute *ibctm.ClientState) bool {
+	return ibctm.IsMatchingClientState(*subject, *substitute) && substitute.LatestHeight.GT(subject.LatestHeight)
+}
+
+// FindSubstitute returns the identifier of the candidate furthest ahead which
+// may replace subject, ties broken by the lowest identifier. The candidates
+// must be active.
+func FindSubstitute(subject *ibctm.ClientState, candidates map[string]*ibctm.ClientState) (string, bool) {
+	var (
+		substituteID string
+		substitute   *ibctm.ClientState
+	)
+	for clientID, candidate := range candidates {
+		if !IsSubstitute(subject, candidate) {
+			continue
+		}
+
+		if substitute == nil || candidate.LatestHeight.GT(substitute.LatestHeight) ||
+			(candidate.LatestHeight.EQ(substitute.LatestHeight) && clientID < substituteID) {
+			substituteID, substitute = clientID, candidate
+		}
+	}
+
+	return substituteID, substitute != nil
+}
@@ -0,0 +1,34 @@
+package feemarket
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/feemarket/keeper"
+	"github.com/fatal-fruit/proton/x/feemarket/types"
+)
+
+// EndBlocker records the gas used by the block and updates the base fee of
+// the next block accordingly.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	var gasUsed uint64
+	if blockGasMeter := ctx.BlockGasMeter(); blockGasMeter != nil {
+		gasUsed = blockGasMeter.GasConsumedToLimit()
+	}
+	baseFee := k.CalculateBaseFee(ctx, gasUsed)
+
+	k.SetBlockGasUsed(ctx, gasUsed)
+	k.SetBaseFee(ctx, baseFee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockGasUsed, sdk.NewIntFromUint64(gasUsed).String()),
+		),
+	)
+}
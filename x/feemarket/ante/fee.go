@@ -0,0 +1,68 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fatal-fruit/proton/x/feemarket/types"
+)
+
+// FeeMarketKeeper defines the expected x/feemarket keeper.
+type FeeMarketKeeper interface {
+	BaseFeeAmount(ctx sdk.Context, gas uint64) sdk.Coins
+	BurnBaseFee(ctx sdk.Context, amount sdk.Coins) error
+}
+
+// BypassKeeper defines the expected keeper telling which txs are exempt from
+// fees, the x/globalfee keeper.
+type BypassKeeper interface {
+	IsBypassTx(ctx sdk.Context, msgs []sdk.Msg, gas uint64) bool
+}
+
+// FeeMarketDecorator rejects txs which do not pay the base fee for their gas
+// limit and burns the base fee part of the fee. It must run after the
+// DeductFeeDecorator so the fee has already been collected. Txs exempt from
+// fees by the bypass keeper pay no base fee.
+type FeeMarketDecorator struct {
+	keeper       FeeMarketKeeper
+	bypassKeeper BypassKeeper
+}
+
+func NewFeeMarketDecorator(k FeeMarketKeeper, bk BypassKeeper) FeeMarketDecorator {
+	return FeeMarketDecorator{
+		keeper:       k,
+		bypassKeeper: bk,
+	}
+}
+
+func (fmd FeeMarketDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// fees are not known yet when estimating gas
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	if fmd.bypassKeeper.IsBypassTx(ctx, feeTx.GetMsgs(), feeTx.GetGas()) {
+		return next(ctx, tx, simulate)
+	}
+
+	baseFee := fmd.keeper.BaseFeeAmount(ctx, feeTx.GetGas())
+	if baseFee.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	if !feeTx.GetFee().IsAllGTE(baseFee) {
+		return ctx, errorsmod.Wrapf(types.ErrInsufficientFee, "got: %s required: %s", feeTx.GetFee(), baseFee)
+	}
+
+	if err := fmd.keeper.BurnBaseFee(ctx, baseFee); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/feemarket/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the feemarket module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// BaseFee returns the current base fee gas price.
+func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	baseFee := sdk.NewDecCoinFromDec(k.GetParams(ctx).FeeDenom, k.GetBaseFee(ctx))
+
+	return &types.QueryBaseFeeResponse{BaseFee: baseFee}, nil
+}
+
+// BlockGas returns the gas used by the last block.
+func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*types.QueryBlockGasResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBlockGasResponse{Gas: k.GetBlockGasUsed(ctx)}, nil
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/fatal-fruit/proton/x/feemarket/types"
+)
+
+// Keeper of the x/feemarket store
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	bankKeeper types.BankKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new x/feemarket Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, bk types.BankKeeper, authority string) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		bankKeeper: bk,
+		authority:  authority,
+	}
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/feemarket module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/feemarket module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetBaseFee sets the base fee gas price.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.BaseFeeKey, bz)
+}
+
+// GetBaseFee returns the base fee gas price. It is zero until set.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseFeeKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var baseFee sdk.Dec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return baseFee
+}
+
+// SetBlockGasUsed stores the gas used by the last block.
+func (k Keeper) SetBlockGasUsed(ctx sdk.Context, gas uint64) {
+	ctx.KVStore(k.storeKey).Set(types.BlockGasUsedKey, sdk.Uint64ToBigEndian(gas))
+}
+
+// GetBlockGasUsed returns the gas used by the last block.
+func (k Keeper) GetBlockGasUsed(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlockGasUsedKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// BaseFeeAmount returns the part of the fee of a tx with the given gas limit
+// which covers the base fee, rounded up. It is empty while the base fee is zero.
+func (k Keeper) BaseFeeAmount(ctx sdk.Context, gas uint64) sdk.Coins {
+	baseFee := k.GetBaseFee(ctx)
+	if !baseFee.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	amount := baseFee.MulInt64(int64(gas)).Ceil().RoundInt()
+	return sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).FeeDenom, amount))
+}
+
+// BurnBaseFee burns the base fee part of a fee already collected by the fee
+// collector.
+func (k Keeper) BurnBaseFee(ctx sdk.Context, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnFee,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// CalculateBaseFee returns the base fee of the next block given the gas used
+// by the current one. The base fee moves towards the target block utilization
+// by at most 1/BaseFeeChangeDenominator and never drops below MinBaseFee.
+func (k Keeper) CalculateBaseFee(ctx sdk.Context, gasUsed uint64) sdk.Dec {
+	params := k.GetParams(ctx)
+	baseFee := k.GetBaseFee(ctx)
+
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(k.maxBlockGas(ctx, params))).Mul(params.TargetBlockUtilization).TruncateInt()
+	if !target.IsPositive() || params.BaseFeeChangeDenominator == 0 {
+		return sdk.MaxDec(baseFee, params.MinBaseFee)
+	}
+
+	used := sdk.NewIntFromUint64(gasUsed)
+	delta := baseFee.MulInt(used.Sub(target).Abs()).QuoInt(target).QuoInt64(int64(params.BaseFeeChangeDenominator))
+
+	switch {
+	case used.GT(target):
+		baseFee = baseFee.Add(delta)
+	case used.LT(target):
+		baseFee = baseFee.Sub(delta)
+	}
+
+	return sdk.MaxDec(baseFee, params.MinBaseFee)
+}
+
+// maxBlockGas returns the block gas limit from the consensus params, falling
+// back to the module parameter when block gas is unbounded.
+func (k Keeper) maxBlockGas(ctx sdk.Context, params types.Params) uint64 {
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil && cp.Block.MaxGas > 0 {
+		return uint64(cp.Block.MaxGas)
+	}
+
+	return params.MaxBlockGas
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/x/feemarket/types"
+)
+
+func TestCalculateBaseFee(t *testing.T) {
+	baseFee := sdk.NewDec(8)
+	maxBlockGas := types.DefaultMaxBlockGas
+	target := maxBlockGas / 2
+
+	testCases := []struct {
+		name       string
+		minBaseFee sdk.Dec
+		gasUsed    uint64
+		expBaseFee sdk.Dec
+	}{
+		{"block at target keeps the base fee", sdk.ZeroDec(), target, baseFee},
+		{"full block raises the base fee by 1/8", sdk.ZeroDec(), maxBlockGas, sdk.NewDec(9)},
+		{"empty block lowers the base fee by 1/8", sdk.ZeroDec(), 0, sdk.NewDec(7)},
+		{"base fee does not drop below the minimum", sdk.NewDecWithPrec(75, 1), 0, sdk.NewDecWithPrec(75, 1)},
+		{"raised minimum lifts the base fee", sdk.NewDec(10), target, sdk.NewDec(10)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			protonApp := app.Setup(t)
+			ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+			k := protonApp.FeeMarketKeeper
+
+			params := types.DefaultParams()
+			params.MinBaseFee = tc.minBaseFee
+			require.NoError(t, k.SetParams(ctx, params))
+			k.SetBaseFee(ctx, baseFee)
+
+			require.Equal(t, tc.expBaseFee, k.CalculateBaseFee(ctx, tc.gasUsed))
+		})
+	}
+}
+
+func TestBaseFeeAmount(t *testing.T) {
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	k := protonApp.FeeMarketKeeper
+
+	params := types.DefaultParams()
+	params.FeeDenom = app.BondDenom
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.True(t, k.BaseFeeAmount(ctx, 100_000).IsZero())
+
+	k.SetBaseFee(ctx, sdk.NewDecWithPrec(25, 4))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 251)), k.BaseFeeAmount(ctx, 100_001))
+}
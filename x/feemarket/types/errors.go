@@ -0,0 +1,10 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/feemarket module sentinel errors
+var (
+	ErrInsufficientFee = errorsmod.Register(ModuleName, 2, "fee is below the base fee")
+)
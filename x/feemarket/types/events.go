@@ -0,0 +1,10 @@
+package types
+
+// x/feemarket module event types
+const (
+	EventTypeFeeMarket = "fee_market"
+	EventTypeBurnFee   = "burn_base_fee"
+
+	AttributeKeyBaseFee      = "base_fee"
+	AttributeKeyBlockGasUsed = "block_gas_used"
+)
@@ -0,0 +1,509 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proton/feemarket/v1/feemarket.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the set of x/feemarket parameters.
+type Params struct {
+	// fee_denom is the denom the base fee is charged in.
+	FeeDenom string `protobuf:"bytes,1,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+	// min_base_fee is the floor of the base fee gas price. A zero minimum lets
+	// the base fee fall to zero, which turns the fee market off.
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_fee"`
+	// target_block_utilization is the share of the block gas limit the base fee
+	// steers block gas usage towards.
+	TargetBlockUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_block_utilization,json=targetBlockUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_block_utilization"`
+	// base_fee_change_denominator bounds the amount the base fee can change
+	// between blocks.
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,4,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// max_block_gas is the block gas limit used when the consensus params do
+	// not bound block gas.
+	MaxBlockGas uint64 `protobuf:"varint,5,opt,name=max_block_gas,json=maxBlockGas,proto3" json:"max_block_gas,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ee65200e4b7de14, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlockGas() uint64 {
+	if m != nil {
+		return m.MaxBlockGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "proton.feemarket.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("proton/feemarket/v1/feemarket.proto", fileDescriptor_8ee65200e4b7de14)
+}
+
+var fileDescriptor_8ee65200e4b7de14 = []byte{
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0x41, 0xab, 0xd3, 0x40,
+	0x10, 0xc7, 0xb3, 0xbe, 0xe7, 0xc3, 0xb7, 0xfa, 0x0e, 0x46, 0x91, 0xbc, 0x16, 0xd2, 0x52, 0x41,
+	0x4a, 0xa1, 0x09, 0xc5, 0x9b, 0xe0, 0xc1, 0x18, 0x14, 0x6f, 0x12, 0xf0, 0xe2, 0x25, 0x4c, 0xd2,
+	0x49, 0xba, 0xb4, 0xbb, 0x5b, 0xb2, 0xdb, 0x52, 0xf5, 0x1b, 0xf4, 0xe4, 0xc7, 0xf0, 0xd8, 0x83,
+	0x1f, 0xa2, 0xc7, 0xe2, 0x49, 0x3c, 0x14, 0x69, 0x0f, 0xfd, 0x1a, 0x92, 0x6c, 0x24, 0x3d, 0x78,
+	0xf4, 0xb2, 0xbb, 0x33, 0xff, 0x3f, 0xf3, 0x9b, 0xd9, 0xa1, 0x4f, 0xe7, 0x85, 0xd4, 0x52, 0xf8,
+	0x19, 0x22, 0x87, 0x62, 0x8a, 0xda, 0x5f, 0x8e, 0x9a, 0xc0, 0xab, 0x54, 0xfb, 0x91, 0x31, 0x79,
+	0x4d, 0x7e, 0x39, 0x6a, 0x3d, 0xce, 0x65, 0x2e, 0x2b, 0xc1, 0x2f, 0x5f, 0xc6, 0xda, 0x7a, 0x08,
+	0x9c, 0x09, 0xe9, 0x57, 0x67, 0x9d, 0xba, 0x4d, 0xa5, 0xe2, 0x52, 0xc5, 0xc6, 0x6b, 0x02, 0x23,
+	0xf5, 0xd6, 0x17, 0xf4, 0xea, 0x3d, 0x14, 0xc0, 0x95, 0xdd, 0xa6, 0xd7, 0x19, 0x62, 0x3c, 0x46,
+	0x21, 0xb9, 0x43, 0xba, 0xa4, 0x7f, 0x1d, 0xdd, 0xcb, 0x10, 0xc3, 0x32, 0xb6, 0x53, 0xfa, 0x80,
+	0x33, 0x11, 0x27, 0xa0, 0x30, 0xce, 0x10, 0x9d, 0x3b, 0xa5, 0x1e, 0xbc, 0xda, 0xee, 0x3b, 0xd6,
+	0xaf, 0x7d, 0xe7, 0x59, 0xce, 0xf4, 0x64, 0x91, 0x78, 0xa9, 0xe4, 0x75, 0xf9, 0xfa, 0x1a, 0xaa,
+	0xf1, 0xd4, 0xd7, 0x9f, 0xe6, 0xa8, 0xbc, 0x10, 0xd3, 0x1f, 0xdf, 0x87, 0xb4, 0xa6, 0x87, 0x98,
+	0x7e, 0x3b, 0x6d, 0x06, 0x24, 0xa2, 0x9c, 0x89, 0x00, 0x14, 0xbe, 0x41, 0xb4, 0xbf, 0x50, 0x47,
+	0x43, 0x91, 0xa3, 0x8e, 0x93, 0x99, 0x4c, 0xa7, 0xf1, 0x42, 0xb3, 0x19, 0xfb, 0x0c, 0x9a, 0x49,
+	0xe1, 0x5c, 0xfc, 0x2f, 0xe0, 0x13, 0x83, 0x08, 0x4a, 0xc2, 0x87, 0x06, 0x60, 0xbf, 0xa4, 0xed,
+	0xbf, 0xd3, 0xc5, 0xe9, 0x04, 0x44, 0x5e, 0x7f, 0x05, 0x13, 0xa0, 0x65, 0xe1, 0x5c, 0x76, 0x49,
+	0xff, 0x26, 0x72, 0x12, 0xd3, 0xea, 0xeb, 0xca, 0x10, 0x36, 0xba, 0xdd, 0xa3, 0x37, 0x1c, 0x56,
+	0x75, 0xe3, 0x39, 0x28, 0xe7, 0x6e, 0x97, 0xf4, 0x2f, 0xa3, 0xfb, 0x1c, 0x56, 0x15, 0xea, 0x2d,
+	0xa8, 0x17, 0xee, 0xfa, 0xb4, 0x19, 0xdc, 0xd6, 0xfb, 0x5e, 0x9d, 0x6d, 0xdc, 0x6c, 0x20, 0x78,
+	0xb7, 0x3d, 0xb8, 0x64, 0x77, 0x70, 0xc9, 0xef, 0x83, 0x4b, 0xbe, 0x1e, 0x5d, 0x6b, 0x77, 0x74,
+	0xad, 0x9f, 0x47, 0xd7, 0xfa, 0xe8, 0x9f, 0xcd, 0x9b, 0x81, 0x86, 0xd9, 0x30, 0x2b, 0x16, 0x4c,
+	0xfb, 0xff, 0xa8, 0x55, 0x0d, 0x9f, 0x5c, 0x55, 0xca, 0xf3, 0x3f, 0x03, 0x00, 0x03, 0x71, 0x68,
+	0xeb, 0x5e, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBlockGas != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.MaxBlockGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TargetBlockUtilization.Size()
+		i -= size
+		if _, err := m.TargetBlockUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.TargetBlockUtilization.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.MaxBlockGas != 0 {
+		n += 1 + sovFeemarket(uint64(m.MaxBlockGas))
+	}
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBlockUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockGas", wireType)
+			}
+			m.MaxBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeemarket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeemarket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeemarket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeemarket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeemarket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeemarket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeemarket = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,18 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the store key of the x/feemarket parameters.
+	ParamsKey = []byte{0x01}
+	// BaseFeeKey is the store key of the current base fee.
+	BaseFeeKey = []byte{0x02}
+	// BlockGasUsedKey is the store key of the gas used by the last block.
+	BlockGasUsedKey = []byte{0x03}
+)
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultBaseFeeChangeDenominator lets the base fee move by at most 12.5%
+	// between blocks, as in EIP-1559.
+	DefaultBaseFeeChangeDenominator uint32 = 8
+	// DefaultMaxBlockGas is the block gas limit used when the consensus params
+	// leave block gas unbounded.
+	DefaultMaxBlockGas uint64 = 100_000_000
+)
+
+var (
+	// DefaultMinBaseFee leaves the fee market off until governance sets a floor.
+	DefaultMinBaseFee = sdk.ZeroDec()
+	// DefaultTargetBlockUtilization targets half full blocks.
+	DefaultTargetBlockUtilization = sdk.NewDecWithPrec(5, 1)
+)
+
+// NewParams creates a new Params instance.
+func NewParams(feeDenom string, minBaseFee, targetUtilization sdk.Dec, changeDenominator uint32, maxBlockGas uint64) Params {
+	return Params{
+		FeeDenom:                 feeDenom,
+		MinBaseFee:               minBaseFee,
+		TargetBlockUtilization:   targetUtilization,
+		BaseFeeChangeDenominator: changeDenominator,
+		MaxBlockGas:              maxBlockGas,
+	}
+}
+
+// DefaultParams returns the default x/feemarket parameters.
+func DefaultParams() Params {
+	return NewParams(
+		sdk.DefaultBondDenom,
+		DefaultMinBaseFee,
+		DefaultTargetBlockUtilization,
+		DefaultBaseFeeChangeDenominator,
+		DefaultMaxBlockGas,
+	)
+}
+
+// Validate performs a stateless validation of the parameters.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+	if p.MinBaseFee.IsNil() || p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee must be non-negative: %s", p.MinBaseFee)
+	}
+	if p.TargetBlockUtilization.IsNil() || !p.TargetBlockUtilization.IsPositive() || p.TargetBlockUtilization.GT(sdk.OneDec()) {
+		return fmt.Errorf("target block utilization must be within (0, 1]: %s", p.TargetBlockUtilization)
+	}
+	if p.BaseFeeChangeDenominator == 0 {
+		return fmt.Errorf("base fee change denominator cannot be zero")
+	}
+	if p.MaxBlockGas == 0 {
+		return fmt.Errorf("max block gas cannot be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/fatal-fruit/proton/x/feepolicy/types"
+)
+
+// GetQueryCmd returns the cli query commands for the feepolicy module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feepolicy module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryChannelIncentive(),
+		GetCmdQueryChannelIncentives(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryChannelIncentive implements a command to return the incentive of
+// a channel.
+func GetCmdQueryChannelIncentive() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "incentive [port-id] [channel-id]",
+		Short:   "Query the relayer incentive of a channel, with its budget and the fees spent",
+		Example: "protond query feepolicy incentive transfer channel-0",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelIncentive(cmd.Context(), &types.QueryChannelIncentiveRequest{PortId: args[0], ChannelId: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Incentive)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryChannelIncentives implements a command to return the incentives
+// of all channels.
+func GetCmdQueryChannelIncentives() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incentives",
+		Short: "Query the relayer incentives of all channels",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ChannelIncentives(cmd.Context(), &types.QueryChannelIncentivesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "incentives")
+
+	return cmd
+}
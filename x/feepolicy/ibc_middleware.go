@@ -0,0 +1,63 @@
+package feepolicy
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+
+	"github.com/fatal-fruit/proton/x/feepolicy/keeper"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware accounts for the relayer fees the keeper attached to the
+// packets of the wrapped ICS-29 fee middleware: once the fees are distributed
+// on acknowledgement, timeout or channel closure, the fees refunded to the
+// module account return to the budget of the channel. Fees are attached by
+// the keeper acting as ICS4Wrapper of the sending keepers. Every other
+// callback goes to the wrapped app unchanged.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping app, which must be
+// the ICS-29 fee middleware.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnChanCloseConfirm implements the IBCModule interface. The fees escrowed on
+// the channel are refunded as it closes.
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.keeper.SettleChannel(ctx, portID, channelID, func() error {
+		return im.IBCModule.OnChanCloseConfirm(ctx, portID, channelID)
+	})
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.keeper.SettlePacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, func() error {
+		return im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	})
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	return im.keeper.SettlePacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, func() error {
+		return im.IBCModule.OnTimeoutPacket(ctx, packet, relayer)
+	})
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/feepolicy/types"
+)
+
+// InitGenesis new feepolicy genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	// ensure the module account holding the budgets exists
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	for _, incentive := range data.Incentives {
+		k.SetChannelIncentive(ctx, incentive)
+	}
+
+	for _, packet := range data.Packets {
+		k.SetIncentivizedPacket(ctx, packet)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllChannelIncentives(ctx), k.GetIncentivizedPackets(ctx, types.IncentivizedPacketPrefix))
+}
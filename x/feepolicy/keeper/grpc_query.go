@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/fatal-fruit/proton/x/feepolicy/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ChannelIncentive returns the incentive of a channel.
+func (k Keeper) ChannelIncentive(c context.Context, req *types.QueryChannelIncentiveRequest) (*types.QueryChannelIncentiveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateChannel(req.PortId, req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	incentive, found := k.GetChannelIncentive(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(types.ErrIncentiveNotFound, "%s/%s", req.PortId, req.ChannelId).Error())
+	}
+
+	return &types.QueryChannelIncentiveResponse{Incentive: incentive}, nil
+}
+
+// ChannelIncentives returns the incentives of all channels.
+func (k Keeper) ChannelIncentives(c context.Context, req *types.QueryChannelIncentivesRequest) (*types.QueryChannelIncentivesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelIncentivePrefix)
+
+	var incentives []types.ChannelIncentive
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var incentive types.ChannelIncentive
+		if err := k.cdc.Unmarshal(value, &incentive); err != nil {
+			return err
+		}
+
+		incentives = append(incentives, incentive)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryChannelIncentivesResponse{Incentives: incentives, Pagination: pageRes}, nil
+}
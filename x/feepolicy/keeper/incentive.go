@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/fatal-fruit/proton/x/feepolicy/types"
+)
+
+// FundChannelIncentive sets the fee attached to the packets sent over
+// channelID on portID and adds amount, drawn from the community pool, to the
+// budget of the channel.
+func (k Keeper) FundChannelIncentive(ctx sdk.Context, portID, channelID string, fee ibcfeetypes.Fee, amount sdk.Coins) (types.ChannelIncentive, error) {
+	incentive, found := k.GetChannelIncentive(ctx, portID, channelID)
+	if !found {
+		incentive = types.NewChannelIncentive(portID, channelID, fee)
+	}
+	incentive.Fee = fee
+
+	if !amount.IsZero() {
+		// create the module account before it receives funds, so it is not
+		// created as a base account
+		moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+		if err := k.distrKeeper.DistributeFromFeePool(ctx, amount, moduleAcc.GetAddress()); err != nil {
+			return types.ChannelIncentive{}, errorsmod.Wrap(err, "failed to fund the channel incentive from the community pool")
+		}
+		incentive.Budget = incentive.Budget.Add(amount...)
+	}
+
+	if err := incentive.Validate(); err != nil {
+		return types.ChannelIncentive{}, err
+	}
+	k.SetChannelIncentive(ctx, incentive)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetIncentive,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.Total().String()),
+			sdk.NewAttribute(types.AttributeKeyBudget, incentive.Budget.String()),
+		),
+	)
+
+	return incentive, nil
+}
+
+// RemoveChannelIncentive removes the incentive of channelID on portID and
+// returns its budget to the community pool. The fees refunded for its packets
+// still in flight go to the community pool as well.
+func (k Keeper) RemoveChannelIncentive(ctx sdk.Context, portID, channelID string) error {
+	incentive, found := k.GetChannelIncentive(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrIncentiveNotFound, "%s/%s", portID, channelID)
+	}
+
+	if !incentive.Budget.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, incentive.Budget, k.GetModuleAddress()); err != nil {
+			return err
+		}
+	}
+	k.DeleteChannelIncentive(ctx, portID, channelID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveIncentive,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyBudget, incentive.Budget.String()),
+		),
+	)
+
+	return nil
+}
+
+// AttachIncentive escrows the fee of the incentive of channelID on portID for
+// the packet of sequence, paid from the budget of the channel. Packets are
+// left without a fee if the channel is not incentivized, is not fee enabled
+// or its budget is exhausted.
+func (k Keeper) AttachIncentive(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	incentive, found := k.GetChannelIncentive(ctx, portID, channelID)
+	if !found || !k.feeKeeper.IsFeeEnabled(ctx, portID, channelID) {
+		return
+	}
+
+	total := incentive.Fee.Total()
+	budget, hasNeg := incentive.Budget.SafeSub(total...)
+	if hasNeg {
+		k.Logger(ctx).Info("channel incentive budget exhausted", "port_id", portID, "channel_id", channelID, "budget", incentive.Budget, "fee", total)
+		return
+	}
+
+	// a failing escrow must not fail the send of the packet
+	cacheCtx, writeFn := ctx.CacheContext()
+	packetFee := ibcfeetypes.NewPacketFee(incentive.Fee, k.GetModuleAddress().String(), nil)
+	msg := ibcfeetypes.NewMsgPayPacketFeeAsync(channeltypes.NewPacketID(portID, channelID, sequence), packetFee)
+	if _, err := k.feeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(cacheCtx), msg); err != nil {
+		k.Logger(ctx).Error("failed to attach relayer fee", "port_id", portID, "channel_id", channelID, "sequence", sequence, "error", err)
+		return
+	}
+	writeFn()
+
+	incentive.Budget = budget
+	incentive.Escrowed = incentive.Escrowed.Add(total...)
+	k.SetChannelIncentive(ctx, incentive)
+	k.SetIncentivizedPacket(ctx, types.IncentivizedPacket{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Fee:       total,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttachFee,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyFee, total.String()),
+		),
+	)
+}
+
+// SettlePacket runs distribute, which distributes the fees escrowed for the
+// packet of sequence on channelID and portID, and accounts for them: the fees
+// refunded to the module account return to the budget of the channel, the
+// rest was spent on relayers.
+func (k Keeper) SettlePacket(ctx sdk.Context, portID, channelID string, sequence uint64, distribute func() error) error {
+	packet, found := k.GetIncentivizedPacket(ctx, portID, channelID, sequence)
+	if !found {
+		return distribute()
+	}
+
+	refund, err := k.collectRefunds(ctx, distribute)
+	if err != nil {
+		return err
+	}
+
+	k.DeleteIncentivizedPacket(ctx, portID, channelID, sequence)
+	return k.settle(ctx, portID, channelID, packet.Fee, refund)
+}
+
+// SettleChannel runs distribute, which refunds all the fees escrowed on
+// channelID and portID as the channel closes, and accounts for them.
+func (k Keeper) SettleChannel(ctx sdk.Context, portID, channelID string, distribute func() error) error {
+	packets := k.GetIncentivizedPackets(ctx, types.ChannelPacketsPrefix(portID, channelID))
+	if len(packets) == 0 {
+		return distribute()
+	}
+
+	refund, err := k.collectRefunds(ctx, distribute)
+	if err != nil {
+		return err
+	}
+
+	escrowed := sdk.NewCoins()
+	for _, packet := range packets {
+		escrowed = escrowed.Add(packet.Fee...)
+		k.DeleteIncentivizedPacket(ctx, portID, channelID, packet.Sequence)
+	}
+
+	return k.settle(ctx, portID, channelID, escrowed, refund)
+}
+
+// collectRefunds runs distribute and returns the coins it sent to the module
+// account.
+func (k Keeper) collectRefunds(ctx sdk.Context, distribute func() error) (sdk.Coins, error) {
+	moduleAddr := k.GetModuleAddress()
+	before := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+	if err := distribute(); err != nil {
+		return nil, err
+	}
+
+	refund, hasNeg := k.bankKeeper.GetAllBalances(ctx, moduleAddr).SafeSub(before...)
+	if hasNeg {
+		return sdk.NewCoins(), nil
+	}
+
+	return refund, nil
+}
+
+// settle moves the escrowed fees of channelID on portID out of escrow, the
+// refund back to the budget. Refunds of removed incentives go to the
+// community pool.
+func (k Keeper) settle(ctx sdk.Context, portID, channelID string, escrowed, refund sdk.Coins) error {
+	incentive, found := k.GetChannelIncentive(ctx, portID, channelID)
+	if !found {
+		if refund.IsZero() {
+			return nil
+		}
+
+		return k.distrKeeper.FundCommunityPool(ctx, refund, k.GetModuleAddress())
+	}
+
+	if remaining, hasNeg := incentive.Escrowed.SafeSub(escrowed...); !hasNeg {
+		incentive.Escrowed = remaining
+	} else {
+		incentive.Escrowed = sdk.NewCoins()
+	}
+	if paid, hasNeg := escrowed.SafeSub(refund...); !hasNeg {
+		incentive.Spent = incentive.Spent.Add(paid...)
+	}
+	incentive.Budget = incentive.Budget.Add(refund...)
+	k.SetChannelIncentive(ctx, incentive)
+
+	return nil
+}
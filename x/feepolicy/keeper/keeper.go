@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+
+	"github.com/fatal-fruit/proton/x/feepolicy/types"
+)
+
+// Keeper of the x/feepolicy store
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
+	feeKeeper     types.FeeKeeper
+	ics4Wrapper   porttypes.ICS4Wrapper
+
+	// the address capable of executing the x/feepolicy messages. Typically,
+	// this should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new x/feepolicy Keeper instance. Packets are sent
+// through ics4Wrapper, then the incentive of their channel is escrowed with
+// feeKeeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	dk types.DistrKeeper,
+	feeKeeper types.FeeKeeper,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	authority string,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		distrKeeper:   dk,
+		feeKeeper:     feeKeeper,
+		ics4Wrapper:   ics4Wrapper,
+		authority:     authority,
+	}
+}
+
+// GetAuthority returns the x/feepolicy module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// budgets. It is the refund address of the fees escrowed by the module.
+func (k Keeper) GetModuleAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.ModuleName)
+}
+
+// SetChannelIncentive stores incentive.
+func (k Keeper) SetChannelIncentive(ctx sdk.Context, incentive types.ChannelIncentive) {
+	ctx.KVStore(k.storeKey).Set(types.ChannelIncentiveKey(incentive.PortId, incentive.ChannelId), k.cdc.MustMarshal(&incentive))
+}
+
+// GetChannelIncentive returns the incentive of channelID on portID.
+func (k Keeper) GetChannelIncentive(ctx sdk.Context, portID, channelID string) (types.ChannelIncentive, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ChannelIncentiveKey(portID, channelID))
+	if bz == nil {
+		return types.ChannelIncentive{}, false
+	}
+
+	var incentive types.ChannelIncentive
+	k.cdc.MustUnmarshal(bz, &incentive)
+	return incentive, true
+}
+
+// DeleteChannelIncentive removes the incentive of channelID on portID.
+func (k Keeper) DeleteChannelIncentive(ctx sdk.Context, portID, channelID string) {
+	ctx.KVStore(k.storeKey).Delete(types.ChannelIncentiveKey(portID, channelID))
+}
+
+// GetAllChannelIncentives returns all stored incentives.
+func (k Keeper) GetAllChannelIncentives(ctx sdk.Context) []types.ChannelIncentive {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ChannelIncentivePrefix)
+	defer iter.Close()
+
+	var incentives []types.ChannelIncentive
+	for ; iter.Valid(); iter.Next() {
+		var incentive types.ChannelIncentive
+		k.cdc.MustUnmarshal(iter.Value(), &incentive)
+		incentives = append(incentives, incentive)
+	}
+
+	return incentives
+}
+
+// SetIncentivizedPacket stores packet.
+func (k Keeper) SetIncentivizedPacket(ctx sdk.Context, packet types.IncentivizedPacket) {
+	ctx.KVStore(k.storeKey).Set(types.IncentivizedPacketKey(packet.PortId, packet.ChannelId, packet.Sequence), k.cdc.MustMarshal(&packet))
+}
+
+// GetIncentivizedPacket returns the incentivized packet of sequence on
+// channelID and portID.
+func (k Keeper) GetIncentivizedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.IncentivizedPacket, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.IncentivizedPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.IncentivizedPacket{}, false
+	}
+
+	var packet types.IncentivizedPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// DeleteIncentivizedPacket removes the incentivized packet of sequence on
+// channelID and portID.
+func (k Keeper) DeleteIncentivizedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.IncentivizedPacketKey(portID, channelID, sequence))
+}
+
+// GetIncentivizedPackets returns the incentivized packets stored under
+// prefix.
+func (k Keeper) GetIncentivizedPackets(ctx sdk.Context, prefix []byte) []types.IncentivizedPacket {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+
+	var packets []types.IncentivizedPacket
+	for ; iter.Valid(); iter.Next() {
+		var packet types.IncentivizedPacket
+		k.cdc.MustUnmarshal(iter.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
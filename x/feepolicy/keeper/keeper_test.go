@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/x/feepolicy/types"
+)
+
+func setup(t *testing.T) (*app.ProtonApp, sdk.Context) {
+	t.Helper()
+
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	return protonApp, ctx
+}
+
+func TestGenesisAndQueries(t *testing.T) {
+	protonApp, ctx := setup(t)
+	k := protonApp.FeePolicyKeeper
+
+	stake := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	fee := ibcfeetypes.NewFee(stake, stake, stake)
+	transfer := types.NewChannelIncentive("transfer", "channel-0", fee)
+	transfer.Budget = stake
+	ica := types.NewChannelIncentive("icacontroller-owner", "channel-1", fee)
+	genesis := types.NewGenesisState(
+		[]types.ChannelIncentive{ica, transfer},
+		[]types.IncentivizedPacket{{PortId: "transfer", ChannelId: "channel-0", Sequence: 4, Fee: fee.Total()}},
+	)
+	require.NoError(t, types.ValidateGenesis(*genesis))
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState([]types.ChannelIncentive{transfer, transfer}, nil)))
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState([]types.ChannelIncentive{types.NewChannelIncentive("transfer", "channel-0", ibcfeetypes.Fee{})}, nil)))
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(nil, []types.IncentivizedPacket{{PortId: "transfer", ChannelId: "channel-0", Sequence: 4}})))
+
+	k.InitGenesis(ctx, genesis)
+	require.Equal(t, genesis, k.ExportGenesis(ctx))
+	require.NotNil(t, protonApp.AccountKeeper.GetAccount(ctx, k.GetModuleAddress()))
+
+	res, err := k.ChannelIncentive(sdk.WrapSDKContext(ctx), &types.QueryChannelIncentiveRequest{PortId: "transfer", ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, transfer, res.Incentive)
+
+	_, err = k.ChannelIncentive(sdk.WrapSDKContext(ctx), &types.QueryChannelIncentiveRequest{PortId: "transfer", ChannelId: "channel-9"})
+	require.Error(t, err)
+
+	page, err := k.ChannelIncentives(sdk.WrapSDKContext(ctx), &types.QueryChannelIncentivesRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, page.Incentives, 1)
+	require.Equal(t, uint64(2), page.Pagination.Total)
+
+	require.ErrorIs(t, k.RemoveChannelIncentive(ctx, "transfer", "channel-9"), types.ErrIncentiveNotFound)
+}
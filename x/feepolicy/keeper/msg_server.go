@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/fatal-fruit/proton/x/feepolicy/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/feepolicy MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// SetChannelIncentive sets the incentive of a channel and funds its budget.
+func (ms msgServer) SetChannelIncentive(goCtx context.Context, req *types.MsgSetChannelIncentive) (*types.MsgSetChannelIncentiveResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := ms.FundChannelIncentive(ctx, req.PortId, req.ChannelId, req.Fee, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetChannelIncentiveResponse{}, nil
+}
+
+// RemoveChannelIncentive removes the incentive of a channel.
+func (ms msgServer) RemoveChannelIncentive(goCtx context.Context, req *types.MsgRemoveChannelIncentive) (*types.MsgRemoveChannelIncentiveResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.RemoveChannelIncentive(ctx, req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveChannelIncentiveResponse{}, nil
+}
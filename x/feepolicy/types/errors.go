@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/feepolicy module sentinel errors
+var (
+	ErrInvalidIncentive  = errorsmod.Register(ModuleName, 2, "invalid channel incentive")
+	ErrIncentiveNotFound = errorsmod.Register(ModuleName, 3, "channel incentive not found")
+)
@@ -0,0 +1,14 @@
+package types
+
+// x/feepolicy module event types
+const (
+	EventTypeSetIncentive    = "set_channel_incentive"
+	EventTypeRemoveIncentive = "remove_channel_incentive"
+	EventTypeAttachFee       = "attach_relayer_fee"
+
+	AttributeKeyPortID    = "port_id"
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeySequence  = "sequence"
+	AttributeKeyFee       = "fee"
+	AttributeKeyBudget    = "budget"
+)
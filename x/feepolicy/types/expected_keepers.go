@@ -0,0 +1,36 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+)
+
+// AccountKeeper defines the expected account keeper used to create the
+// x/feepolicy module account.
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
+}
+
+// BankKeeper defines the expected bank keeper used to observe the fees
+// refunded to the x/feepolicy module account.
+type BankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// DistrKeeper defines the expected distribution keeper used to move budgets
+// from and to the community pool.
+type DistrKeeper interface {
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// FeeKeeper defines the expected ICS-29 fee keeper used to escrow the relayer
+// fees.
+type FeeKeeper interface {
+	IsFeeEnabled(ctx sdk.Context, portID, channelID string) bool
+	PayPacketFeeAsync(goCtx context.Context, msg *ibcfeetypes.MsgPayPacketFeeAsync) (*ibcfeetypes.MsgPayPacketFeeAsyncResponse, error)
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewChannelIncentive creates a new ChannelIncentive attaching fee to the
+// packets sent over channelID on portID, with an empty budget.
+func NewChannelIncentive(portID, channelID string, fee ibcfeetypes.Fee) ChannelIncentive {
+	return ChannelIncentive{
+		PortId:    portID,
+		ChannelId: channelID,
+		Fee:       fee,
+	}
+}
+
+// Validate performs a basic validation of the incentive.
+func (i ChannelIncentive) Validate() error {
+	if err := ValidateChannel(i.PortId, i.ChannelId); err != nil {
+		return err
+	}
+
+	if err := i.Fee.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidIncentive, err.Error())
+	}
+
+	for name, coins := range map[string]sdk.Coins{"budget": i.Budget, "escrowed": i.Escrowed, "spent": i.Spent} {
+		if err := coins.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidIncentive, "%s: %s", name, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the packet.
+func (p IncentivizedPacket) Validate() error {
+	if err := ValidateChannel(p.PortId, p.ChannelId); err != nil {
+		return err
+	}
+
+	if p.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidIncentive, "packet sequence cannot be 0")
+	}
+
+	if err := p.Fee.Validate(); err != nil || p.Fee.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidIncentive, "invalid fee of packet %d on %s/%s: %s", p.Sequence, p.PortId, p.ChannelId, p.Fee)
+	}
+
+	return nil
+}
+
+// ValidateChannel validates the port and channel identifiers of an incentive.
+func ValidateChannel(portID, channelID string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return errorsmod.Wrap(ErrInvalidIncentive, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return errorsmod.Wrap(ErrInvalidIncentive, err.Error())
+	}
+
+	return nil
+}
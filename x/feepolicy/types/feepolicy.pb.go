@@ -0,0 +1,963 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proton/feepolicy/v1/feepolicy.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChannelIncentive is the default ICS-29 relayer fee the x/feepolicy module
+// attaches to the packets sent over a channel, paid for by a budget funded
+// from the community pool.
+type ChannelIncentive struct {
+	// port_id is the source port of the incentivized packets.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the source channel of the incentivized packets.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// fee is the relayer fee attached to every packet sent over the channel.
+	Fee types.Fee `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee"`
+	// budget is the funding left to attach fees with. Packets are sent without
+	// a fee once the budget cannot cover the total fee.
+	Budget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=budget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"budget"`
+	// escrowed is the fees attached to packets awaiting an acknowledgement or
+	// a timeout.
+	Escrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=escrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed"`
+	// spent is the fees paid to relayers. Unpaid fees are refunded to budget.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *ChannelIncentive) Reset()         { *m = ChannelIncentive{} }
+func (m *ChannelIncentive) String() string { return proto.CompactTextString(m) }
+func (*ChannelIncentive) ProtoMessage()    {}
+func (*ChannelIncentive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1dd888a8a52506c, []int{0}
+}
+func (m *ChannelIncentive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelIncentive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelIncentive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelIncentive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelIncentive.Merge(m, src)
+}
+func (m *ChannelIncentive) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelIncentive) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelIncentive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelIncentive proto.InternalMessageInfo
+
+func (m *ChannelIncentive) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelIncentive) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelIncentive) GetFee() types.Fee {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Fee{}
+}
+
+func (m *ChannelIncentive) GetBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Budget
+	}
+	return nil
+}
+
+func (m *ChannelIncentive) GetEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrowed
+	}
+	return nil
+}
+
+func (m *ChannelIncentive) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+// IncentivizedPacket is a packet the x/feepolicy module attached a fee to
+// which was not acknowledged or timed out yet.
+type IncentivizedPacket struct {
+	// port_id is the source port of the packet.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the source channel of the packet.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the packet.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// fee is the total fee escrowed for the packet.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *IncentivizedPacket) Reset()         { *m = IncentivizedPacket{} }
+func (m *IncentivizedPacket) String() string { return proto.CompactTextString(m) }
+func (*IncentivizedPacket) ProtoMessage()    {}
+func (*IncentivizedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1dd888a8a52506c, []int{1}
+}
+func (m *IncentivizedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentivizedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentivizedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentivizedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentivizedPacket.Merge(m, src)
+}
+func (m *IncentivizedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentivizedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentivizedPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentivizedPacket proto.InternalMessageInfo
+
+func (m *IncentivizedPacket) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *IncentivizedPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IncentivizedPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *IncentivizedPacket) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ChannelIncentive)(nil), "proton.feepolicy.v1.ChannelIncentive")
+	proto.RegisterType((*IncentivizedPacket)(nil), "proton.feepolicy.v1.IncentivizedPacket")
+}
+
+func init() {
+	proto.RegisterFile("proton/feepolicy/v1/feepolicy.proto", fileDescriptor_a1dd888a8a52506c)
+}
+
+var fileDescriptor_a1dd888a8a52506c = []byte{
+	// 428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x31, 0x6f, 0x13, 0x31,
+	0x14, 0x8e, 0x49, 0x1b, 0x1a, 0x77, 0x01, 0x83, 0xc4, 0x11, 0xc1, 0x35, 0x94, 0x25, 0x42, 0xaa,
+	0xad, 0x2b, 0x62, 0x60, 0x4d, 0x25, 0xa4, 0x6c, 0x28, 0x23, 0x0b, 0xb2, 0x7d, 0xef, 0x12, 0xab,
+	0x17, 0xfb, 0x38, 0xfb, 0x0e, 0xca, 0xaf, 0xe0, 0x67, 0x20, 0x26, 0xfe, 0x04, 0x52, 0x07, 0x86,
+	0x8e, 0x4c, 0x80, 0x92, 0x81, 0xbf, 0x81, 0x7c, 0x36, 0x6d, 0x66, 0xa4, 0x2c, 0x77, 0xf7, 0xde,
+	0xf7, 0xde, 0xf7, 0xdd, 0xe7, 0x4f, 0xc6, 0x4f, 0xab, 0xda, 0x38, 0xa3, 0x59, 0x01, 0x50, 0x99,
+	0x52, 0xc9, 0x0b, 0xd6, 0x66, 0x37, 0x05, 0xed, 0x50, 0x72, 0x2f, 0x0c, 0xd1, 0x9b, 0x7e, 0x9b,
+	0x8d, 0xee, 0x2f, 0xcc, 0xc2, 0x74, 0x00, 0xf3, 0x5f, 0x61, 0x74, 0x74, 0x97, 0xaf, 0x94, 0x36,
+	0xac, 0x7b, 0xc6, 0x56, 0x2a, 0x8d, 0x5d, 0x19, 0xcb, 0x04, 0xb7, 0xc0, 0xda, 0x4c, 0x80, 0xe3,
+	0x19, 0x93, 0x46, 0xe9, 0x88, 0x3f, 0x51, 0x42, 0x32, 0x5e, 0x55, 0xa5, 0x92, 0xdc, 0x29, 0xa3,
+	0xad, 0xd7, 0x8f, 0xbf, 0x11, 0x46, 0x8e, 0xbf, 0xf5, 0xf1, 0x9d, 0xb3, 0x25, 0xd7, 0x1a, 0xca,
+	0x99, 0x96, 0xa0, 0x9d, 0x6a, 0x81, 0x3c, 0xc0, 0xb7, 0x2b, 0x53, 0xbb, 0xb7, 0x2a, 0x4f, 0xd0,
+	0x18, 0x4d, 0x86, 0xf3, 0x81, 0x2f, 0x67, 0x39, 0x79, 0x8c, 0xb1, 0x0c, 0xc3, 0x1e, 0xbb, 0xd5,
+	0x61, 0xc3, 0xd8, 0x99, 0xe5, 0xe4, 0x25, 0xee, 0x17, 0x00, 0x49, 0x7f, 0x8c, 0x26, 0x87, 0xa7,
+	0x8f, 0xa8, 0x12, 0x92, 0x6e, 0xab, 0x7b, 0x97, 0xb4, 0xcd, 0xe8, 0x2b, 0x80, 0xe9, 0xf0, 0xf2,
+	0xe7, 0x51, 0xef, 0xf3, 0x9f, 0xaf, 0xcf, 0xd0, 0xdc, 0xef, 0x90, 0x25, 0x1e, 0x88, 0x26, 0x5f,
+	0x80, 0x4b, 0xf6, 0xc6, 0xfd, 0xc9, 0xe1, 0xe9, 0x43, 0x1a, 0xbc, 0x51, 0xef, 0x8d, 0x46, 0x6f,
+	0xf4, 0xcc, 0x28, 0x3d, 0x7d, 0xe1, 0x57, 0xbf, 0xfc, 0x3a, 0x9a, 0x2c, 0x94, 0x5b, 0x36, 0x82,
+	0x4a, 0xb3, 0x62, 0xf1, 0x20, 0xc2, 0xeb, 0xc4, 0xe6, 0xe7, 0xcc, 0x5d, 0x54, 0x60, 0xbb, 0x05,
+	0x1b, 0x64, 0x22, 0x3f, 0x29, 0xf1, 0x01, 0x58, 0x59, 0x9b, 0xf7, 0x90, 0x27, 0xfb, 0x3b, 0xd2,
+	0xba, 0x56, 0x20, 0x05, 0xde, 0xb7, 0x15, 0x68, 0x97, 0x0c, 0x76, 0x24, 0x15, 0xe8, 0x8f, 0xbf,
+	0x23, 0x4c, 0xfe, 0x05, 0xa8, 0x3e, 0x42, 0xfe, 0x9a, 0xcb, 0x73, 0x70, 0xff, 0x9d, 0xe4, 0x08,
+	0x1f, 0x58, 0x78, 0xd7, 0x80, 0x96, 0x21, 0xce, 0xbd, 0xf9, 0x75, 0x4d, 0x44, 0x48, 0x79, 0x57,
+	0x39, 0x79, 0xf2, 0xe9, 0xec, 0x72, 0x9d, 0xa2, 0xab, 0x75, 0x8a, 0x7e, 0xaf, 0x53, 0xf4, 0x69,
+	0x93, 0xf6, 0xae, 0x36, 0x69, 0xef, 0xc7, 0x26, 0xed, 0xbd, 0x61, 0x5b, 0x6c, 0x05, 0x77, 0xbc,
+	0x3c, 0x29, 0xea, 0x46, 0x39, 0x16, 0x6f, 0xdb, 0x87, 0xad, 0xfb, 0xd6, 0x51, 0x8b, 0x41, 0x87,
+	0x3c, 0xff, 0x3b, 0x00, 0x83, 0xa2, 0xab, 0x2b, 0x90, 0x03, 0x00, 0x00,
+}
+
+func (m *ChannelIncentive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelIncentive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeepolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeepolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Budget) > 0 {
+		for iNdEx := len(m.Budget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeepolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeepolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintFeepolicy(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintFeepolicy(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentivizedPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentivizedPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentivizedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeepolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintFeepolicy(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintFeepolicy(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintFeepolicy(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeepolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeepolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChannelIncentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovFeepolicy(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovFeepolicy(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovFeepolicy(uint64(l))
+	if len(m.Budget) > 0 {
+		for _, e := range m.Budget {
+			l = e.Size()
+			n += 1 + l + sovFeepolicy(uint64(l))
+		}
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovFeepolicy(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovFeepolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncentivizedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovFeepolicy(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovFeepolicy(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovFeepolicy(uint64(m.Sequence))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovFeepolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFeepolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeepolicy(x uint64) (n int) {
+	return sovFeepolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChannelIncentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeepolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelIncentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelIncentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = append(m.Budget, types1.Coin{})
+			if err := m.Budget[len(m.Budget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrowed = append(m.Escrowed, types1.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types1.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeepolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentivizedPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeepolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentivizedPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentivizedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types1.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeepolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeepolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeepolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeepolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeepolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeepolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeepolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeepolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeepolicy = fmt.Errorf("proto: unexpected end of group")
+)
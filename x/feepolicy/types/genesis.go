@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(incentives []ChannelIncentive, packets []IncentivizedPacket) *GenesisState {
+	return &GenesisState{
+		Incentives: incentives,
+		Packets:    packets,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]ChannelIncentive{}, []IncentivizedPacket{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	channels := make(map[string]bool)
+	for _, incentive := range data.Incentives {
+		if err := incentive.Validate(); err != nil {
+			return err
+		}
+
+		channel := string(ChannelIncentiveKey(incentive.PortId, incentive.ChannelId))
+		if channels[channel] {
+			return fmt.Errorf("duplicate incentive of %s/%s", incentive.PortId, incentive.ChannelId)
+		}
+		channels[channel] = true
+	}
+
+	packets := make(map[string]bool)
+	for _, packet := range data.Packets {
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+
+		key := string(IncentivizedPacketKey(packet.PortId, packet.ChannelId, packet.Sequence))
+		if packets[key] {
+			return fmt.Errorf("duplicate packet %d on %s/%s", packet.Sequence, packet.PortId, packet.ChannelId)
+		}
+		packets[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feepolicy"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ChannelIncentivePrefix is the store prefix of the channel incentives,
+	// keyed by port and channel.
+	ChannelIncentivePrefix = []byte{0x01}
+	// IncentivizedPacketPrefix is the store prefix of the incentivized
+	// packets, keyed by port, channel and sequence.
+	IncentivizedPacketPrefix = []byte{0x02}
+)
+
+// ChannelIncentiveKey returns the store key of the incentive of channelID on
+// portID.
+func ChannelIncentiveKey(portID, channelID string) []byte {
+	return append(ChannelIncentivePrefix, channelPath(portID, channelID)...)
+}
+
+// ChannelPacketsPrefix returns the store prefix of the incentivized packets
+// of channelID on portID.
+func ChannelPacketsPrefix(portID, channelID string) []byte {
+	return append(append(IncentivizedPacketPrefix, channelPath(portID, channelID)...), '/')
+}
+
+// IncentivizedPacketKey returns the store key of the incentivized packet of
+// sequence on channelID and portID.
+func IncentivizedPacketKey(portID, channelID string, sequence uint64) []byte {
+	return append(ChannelPacketsPrefix(portID, channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+func channelPath(portID, channelID string) []byte {
+	return []byte(portID + "/" + channelID)
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgSetChannelIncentive{}
+	_ sdk.Msg = &MsgRemoveChannelIncentive{}
+)
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetChannelIncentive) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetChannelIncentive message.
+func (m *MsgSetChannelIncentive) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetChannelIncentive) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidateChannel(m.PortId, m.ChannelId); err != nil {
+		return err
+	}
+
+	if err := m.Fee.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidIncentive, err.Error())
+	}
+
+	if err := m.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveChannelIncentive) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveChannelIncentive message.
+func (m *MsgRemoveChannelIncentive) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRemoveChannelIncentive) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateChannel(m.PortId, m.ChannelId)
+}
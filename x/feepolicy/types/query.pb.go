@@ -0,0 +1,1123 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proton/feepolicy/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryChannelIncentiveRequest is the request type for the
+// Query/ChannelIncentive RPC method.
+type QueryChannelIncentiveRequest struct {
+	// port_id is the source port of the incentivized packets.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the source channel of the incentivized packets.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryChannelIncentiveRequest) Reset()         { *m = QueryChannelIncentiveRequest{} }
+func (m *QueryChannelIncentiveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelIncentiveRequest) ProtoMessage()    {}
+func (*QueryChannelIncentiveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d317c0f3e8a611ce, []int{0}
+}
+func (m *QueryChannelIncentiveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelIncentiveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelIncentiveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelIncentiveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelIncentiveRequest.Merge(m, src)
+}
+func (m *QueryChannelIncentiveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelIncentiveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelIncentiveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelIncentiveRequest proto.InternalMessageInfo
+
+func (m *QueryChannelIncentiveRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryChannelIncentiveRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryChannelIncentiveResponse is the response type for the
+// Query/ChannelIncentive RPC method.
+type QueryChannelIncentiveResponse struct {
+	// incentive is the incentive of the channel.
+	Incentive ChannelIncentive `protobuf:"bytes,1,opt,name=incentive,proto3" json:"incentive"`
+}
+
+func (m *QueryChannelIncentiveResponse) Reset()         { *m = QueryChannelIncentiveResponse{} }
+func (m *QueryChannelIncentiveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelIncentiveResponse) ProtoMessage()    {}
+func (*QueryChannelIncentiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d317c0f3e8a611ce, []int{1}
+}
+func (m *QueryChannelIncentiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelIncentiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelIncentiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelIncentiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelIncentiveResponse.Merge(m, src)
+}
+func (m *QueryChannelIncentiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelIncentiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelIncentiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelIncentiveResponse proto.InternalMessageInfo
+
+func (m *QueryChannelIncentiveResponse) GetIncentive() ChannelIncentive {
+	if m != nil {
+		return m.Incentive
+	}
+	return ChannelIncentive{}
+}
+
+// QueryChannelIncentivesRequest is the request type for the
+// Query/ChannelIncentives RPC method.
+type QueryChannelIncentivesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChannelIncentivesRequest) Reset()         { *m = QueryChannelIncentivesRequest{} }
+func (m *QueryChannelIncentivesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelIncentivesRequest) ProtoMessage()    {}
+func (*QueryChannelIncentivesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d317c0f3e8a611ce, []int{2}
+}
+func (m *QueryChannelIncentivesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelIncentivesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelIncentivesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelIncentivesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelIncentivesRequest.Merge(m, src)
+}
+func (m *QueryChannelIncentivesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelIncentivesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelIncentivesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelIncentivesRequest proto.InternalMessageInfo
+
+func (m *QueryChannelIncentivesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryChannelIncentivesResponse is the response type for the
+// Query/ChannelIncentives RPC method.
+type QueryChannelIncentivesResponse struct {
+	// incentives are the incentives of the channels.
+	Incentives []ChannelIncentive `protobuf:"bytes,1,rep,name=incentives,proto3" json:"incentives"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChannelIncentivesResponse) Reset()         { *m = QueryChannelIncentivesResponse{} }
+func (m *QueryChannelIncentivesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelIncentivesResponse) ProtoMessage()    {}
+func (*QueryChannelIncentivesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d317c0f3e8a611ce, []int{3}
+}
+func (m *QueryChannelIncentivesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelIncentivesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelIncentivesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelIncentivesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelIncentivesResponse.Merge(m, src)
+}
+func (m *QueryChannelIncentivesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelIncentivesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelIncentivesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelIncentivesResponse proto.InternalMessageInfo
+
+func (m *QueryChannelIncentivesResponse) GetIncentives() []ChannelIncentive {
+	if m != nil {
+		return m.Incentives
+	}
+	return nil
+}
+
+func (m *QueryChannelIncentivesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryChannelIncentiveRequest)(nil), "proton.feepolicy.v1.QueryChannelIncentiveRequest")
+	proto.RegisterType((*QueryChannelIncentiveResponse)(nil), "proton.feepolicy.v1.QueryChannelIncentiveResponse")
+	proto.RegisterType((*QueryChannelIncentivesRequest)(nil), "proton.feepolicy.v1.QueryChannelIncentivesRequest")
+	proto.RegisterType((*QueryChannelIncentivesResponse)(nil), "proton.feepolicy.v1.QueryChannelIncentivesResponse")
+}
+
+func init() { proto.RegisterFile("proton/feepolicy/v1/query.proto", fileDescriptor_d317c0f3e8a611ce) }
+
+var fileDescriptor_d317c0f3e8a611ce = []byte{
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x11, 0x2b, 0x19, 0x2f, 0x76, 0x14, 0x2c, 0xa1, 0xdd, 0xd4, 0x15, 0xad, 0x14,
+	0x9c, 0x61, 0x53, 0x10, 0x4f, 0x1e, 0x2a, 0x28, 0xb9, 0x48, 0xcd, 0xc1, 0x83, 0x17, 0x99, 0x6c,
+	0x26, 0xdb, 0x81, 0x64, 0xde, 0x36, 0x33, 0x09, 0x86, 0xd2, 0x8b, 0x9f, 0x40, 0xf0, 0x33, 0x08,
+	0x1e, 0xbd, 0x8a, 0x5f, 0xa0, 0xc7, 0x82, 0x17, 0x4f, 0x22, 0x89, 0xe0, 0xc1, 0x2f, 0x21, 0x3b,
+	0x33, 0xdb, 0x8d, 0x75, 0x63, 0x88, 0x97, 0x90, 0xcc, 0xff, 0xbd, 0xff, 0xfb, 0xbd, 0x7f, 0x1e,
+	0x6e, 0xa4, 0x43, 0x30, 0xa0, 0x58, 0x4f, 0x88, 0x14, 0xfa, 0x32, 0x9e, 0xb0, 0x71, 0xc4, 0x8e,
+	0x46, 0x62, 0x38, 0xa1, 0x56, 0x21, 0xd7, 0x5d, 0x01, 0x3d, 0x2f, 0xa0, 0xe3, 0xa8, 0x7e, 0x23,
+	0x81, 0x04, 0xac, 0xc0, 0xb2, 0x6f, 0xae, 0xb4, 0xbe, 0x99, 0x00, 0x24, 0x7d, 0xc1, 0x78, 0x2a,
+	0x19, 0x57, 0x0a, 0x0c, 0x37, 0x12, 0x94, 0xf6, 0xea, 0x3a, 0x1f, 0x48, 0x05, 0xcc, 0x7e, 0xfa,
+	0xa7, 0xdd, 0x18, 0xf4, 0x00, 0x34, 0xeb, 0x70, 0x2d, 0xdc, 0x50, 0x36, 0x8e, 0x3a, 0xc2, 0xf0,
+	0x88, 0xa5, 0x3c, 0x91, 0xca, 0xf6, 0xfb, 0xda, 0xdb, 0x65, 0xa0, 0x05, 0x94, 0x55, 0xc3, 0x17,
+	0x78, 0xf3, 0x79, 0x66, 0xf3, 0xf8, 0x90, 0x2b, 0x25, 0xfa, 0x2d, 0x15, 0x0b, 0x65, 0xe4, 0x58,
+	0xb4, 0xc5, 0xd1, 0x48, 0x68, 0x43, 0x6e, 0xe2, 0x2b, 0x29, 0x0c, 0xcd, 0x2b, 0xd9, 0xdd, 0x40,
+	0xdb, 0xe8, 0x5e, 0xad, 0xbd, 0x96, 0xfd, 0x6c, 0x75, 0xc9, 0x16, 0xc6, 0xb1, 0xeb, 0xc9, 0xb4,
+	0xaa, 0xd5, 0x6a, 0xfe, 0xa5, 0xd5, 0x0d, 0x01, 0x6f, 0x2d, 0xf0, 0xd5, 0x29, 0x28, 0x2d, 0xc8,
+	0x33, 0x5c, 0x93, 0xf9, 0xa3, 0xb5, 0xbe, 0xda, 0xbc, 0x43, 0x4b, 0x92, 0xa3, 0x17, 0x1d, 0xf6,
+	0x6b, 0xa7, 0xdf, 0x1a, 0x95, 0x0f, 0x3f, 0x3f, 0xee, 0xa2, 0x76, 0x61, 0x11, 0x26, 0x0b, 0x06,
+	0xea, 0x7c, 0x93, 0x27, 0x18, 0x17, 0x11, 0xf9, 0x89, 0x77, 0xa9, 0xcb, 0x93, 0x66, 0x79, 0x52,
+	0xf7, 0x27, 0xfa, 0x3c, 0xe9, 0x01, 0x4f, 0xf2, 0x14, 0xda, 0x73, 0x9d, 0xe1, 0x67, 0x84, 0x83,
+	0x45, 0x93, 0xfc, 0x6e, 0x07, 0x18, 0x9f, 0x83, 0xe9, 0x0d, 0xb4, 0x7d, 0xe9, 0xbf, 0x96, 0x9b,
+	0xf3, 0x20, 0x4f, 0xff, 0x80, 0xaf, 0x5a, 0xf8, 0x9d, 0xa5, 0xf0, 0x0e, 0x67, 0x9e, 0xbe, 0xf9,
+	0xab, 0x8a, 0x2f, 0x5b, 0x7a, 0xf2, 0x09, 0xe1, 0x6b, 0x17, 0xc7, 0x93, 0xa8, 0x94, 0xf2, 0x5f,
+	0x17, 0x52, 0x6f, 0xae, 0xd2, 0xe2, 0x88, 0xc2, 0x47, 0x6f, 0xbe, 0xfc, 0x78, 0x57, 0x7d, 0x48,
+	0x1e, 0xb0, 0xb2, 0x1b, 0x2d, 0xf6, 0x66, 0xc7, 0xfe, 0xf8, 0x4e, 0xd8, 0x71, 0x71, 0x6d, 0x27,
+	0xe4, 0x3d, 0xc2, 0xeb, 0x7f, 0xc5, 0x4f, 0x56, 0x20, 0xc9, 0xaf, 0xa2, 0xbe, 0xb7, 0x52, 0x8f,
+	0xc7, 0xdf, 0xb1, 0xf8, 0xb7, 0x48, 0x63, 0x09, 0xfe, 0x7e, 0xeb, 0x74, 0x1a, 0xa0, 0xb3, 0x69,
+	0x80, 0xbe, 0x4f, 0x03, 0xf4, 0x76, 0x16, 0x54, 0xce, 0x66, 0x41, 0xe5, 0xeb, 0x2c, 0xa8, 0xbc,
+	0x64, 0x89, 0x34, 0x87, 0xa3, 0x0e, 0x8d, 0x61, 0xc0, 0x7a, 0xdc, 0xf0, 0xfe, 0xfd, 0xde, 0x70,
+	0x24, 0x4d, 0x6e, 0xf8, 0x7a, 0xce, 0xd2, 0x4c, 0x52, 0xa1, 0x3b, 0x6b, 0x56, 0xd9, 0xfb, 0x3d,
+	0x00, 0xc6, 0x9f, 0x03, 0x53, 0x7f, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ChannelIncentive returns the incentive of a channel, with its budget and
+	// the fees spent.
+	ChannelIncentive(ctx context.Context, in *QueryChannelIncentiveRequest, opts ...grpc.CallOption) (*QueryChannelIncentiveResponse, error)
+	// ChannelIncentives returns the incentives of all channels.
+	ChannelIncentives(ctx context.Context, in *QueryChannelIncentivesRequest, opts ...grpc.CallOption) (*QueryChannelIncentivesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ChannelIncentive(ctx context.Context, in *QueryChannelIncentiveRequest, opts ...grpc.CallOption) (*QueryChannelIncentiveResponse, error) {
+	out := new(QueryChannelIncentiveResponse)
+	err := c.cc.Invoke(ctx, "/proton.feepolicy.v1.Query/ChannelIncentive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelIncentives(ctx context.Context, in *QueryChannelIncentivesRequest, opts ...grpc.CallOption) (*QueryChannelIncentivesResponse, error) {
+	out := new(QueryChannelIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/proton.feepolicy.v1.Query/ChannelIncentives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ChannelIncentive returns the incentive of a channel, with its budget and
+	// the fees spent.
+	ChannelIncentive(context.Context, *QueryChannelIncentiveRequest) (*QueryChannelIncentiveResponse, error)
+	// ChannelIncentives returns the incentives of all channels.
+	ChannelIncentives(context.Context, *QueryChannelIncentivesRequest) (*QueryChannelIncentivesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ChannelIncentive(ctx context.Context, req *QueryChannelIncentiveRequest) (*QueryChannelIncentiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelIncentive not implemented")
+}
+func (*UnimplementedQueryServer) ChannelIncentives(ctx context.Context, req *QueryChannelIncentivesRequest) (*QueryChannelIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelIncentives not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ChannelIncentive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelIncentiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelIncentive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proton.feepolicy.v1.Query/ChannelIncentive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelIncentive(ctx, req.(*QueryChannelIncentiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelIncentivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proton.feepolicy.v1.Query/ChannelIncentives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelIncentives(ctx, req.(*QueryChannelIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proton.feepolicy.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ChannelIncentive",
+			Handler:    _Query_ChannelIncentive_Handler,
+		},
+		{
+			MethodName: "ChannelIncentives",
+			Handler:    _Query_ChannelIncentives_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proton/feepolicy/v1/query.proto",
+}
+
+func (m *QueryChannelIncentiveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelIncentiveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelIncentiveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelIncentiveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelIncentiveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelIncentiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Incentive.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelIncentivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelIncentivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Incentives) > 0 {
+		for iNdEx := len(m.Incentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Incentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryChannelIncentiveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelIncentiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Incentive.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChannelIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Incentives) > 0 {
+		for _, e := range m.Incentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryChannelIncentiveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelIncentiveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelIncentiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelIncentiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelIncentiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Incentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelIncentivesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelIncentivesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelIncentivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelIncentivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Incentives = append(m.Incentives, ChannelIncentive{})
+			if err := m.Incentives[len(m.Incentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1221 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proton/feepolicy/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetChannelIncentive is the Msg/SetChannelIncentive request type.
+type MsgSetChannelIncentive struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// port_id is the source port of the incentivized packets.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the source channel of the incentivized packets.
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// fee is the relayer fee attached to every packet sent over the channel.
+	Fee types.Fee `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee"`
+	// amount is drawn from the community pool and added to the budget of the
+	// channel. It may be empty to only update the fee.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgSetChannelIncentive) Reset()         { *m = MsgSetChannelIncentive{} }
+func (m *MsgSetChannelIncentive) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelIncentive) ProtoMessage()    {}
+func (*MsgSetChannelIncentive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d1893eed4bd5df, []int{0}
+}
+func (m *MsgSetChannelIncentive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelIncentive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelIncentive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetChannelIncentive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelIncentive.Merge(m, src)
+}
+func (m *MsgSetChannelIncentive) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelIncentive) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelIncentive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChannelIncentive proto.InternalMessageInfo
+
+func (m *MsgSetChannelIncentive) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetChannelIncentive) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MsgSetChannelIncentive) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgSetChannelIncentive) GetFee() types.Fee {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Fee{}
+}
+
+func (m *MsgSetChannelIncentive) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgSetChannelIncentiveResponse defines the response structure for executing
+// a MsgSetChannelIncentive message.
+type MsgSetChannelIncentiveResponse struct {
+}
+
+func (m *MsgSetChannelIncentiveResponse) Reset()         { *m = MsgSetChannelIncentiveResponse{} }
+func (m *MsgSetChannelIncentiveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelIncentiveResponse) ProtoMessage()    {}
+func (*MsgSetChannelIncentiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d1893eed4bd5df, []int{1}
+}
+func (m *MsgSetChannelIncentiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelIncentiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelIncentiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetChannelIncentiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelIncentiveResponse.Merge(m, src)
+}
+func (m *MsgSetChannelIncentiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelIncentiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelIncentiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChannelIncentiveResponse proto.InternalMessageInfo
+
+// MsgRemoveChannelIncentive is the Msg/RemoveChannelIncentive request type.
+type MsgRemoveChannelIncentive struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// port_id is the source port of the incentivized packets.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the source channel of the incentivized packets.
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgRemoveChannelIncentive) Reset()         { *m = MsgRemoveChannelIncentive{} }
+func (m *MsgRemoveChannelIncentive) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveChannelIncentive) ProtoMessage()    {}
+func (*MsgRemoveChannelIncentive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d1893eed4bd5df, []int{2}
+}
+func (m *MsgRemoveChannelIncentive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveChannelIncentive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveChannelIncentive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveChannelIncentive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveChannelIncentive.Merge(m, src)
+}
+func (m *MsgRemoveChannelIncentive) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveChannelIncentive) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveChannelIncentive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveChannelIncentive proto.InternalMessageInfo
+
+func (m *MsgRemoveChannelIncentive) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveChannelIncentive) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MsgRemoveChannelIncentive) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// MsgRemoveChannelIncentiveResponse defines the response structure for
+// executing a MsgRemoveChannelIncentive message.
+type MsgRemoveChannelIncentiveResponse struct {
+}
+
+func (m *MsgRemoveChannelIncentiveResponse) Reset()         { *m = MsgRemoveChannelIncentiveResponse{} }
+func (m *MsgRemoveChannelIncentiveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveChannelIncentiveResponse) ProtoMessage()    {}
+func (*MsgRemoveChannelIncentiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d1893eed4bd5df, []int{3}
+}
+func (m *MsgRemoveChannelIncentiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveChannelIncentiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveChannelIncentiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveChannelIncentiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveChannelIncentiveResponse.Merge(m, src)
+}
+func (m *MsgRemoveChannelIncentiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveChannelIncentiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveChannelIncentiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveChannelIncentiveResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetChannelIncentive)(nil), "proton.feepolicy.v1.MsgSetChannelIncentive")
+	proto.RegisterType((*MsgSetChannelIncentiveResponse)(nil), "proton.feepolicy.v1.MsgSetChannelIncentiveResponse")
+	proto.RegisterType((*MsgRemoveChannelIncentive)(nil), "proton.feepolicy.v1.MsgRemoveChannelIncentive")
+	proto.RegisterType((*MsgRemoveChannelIncentiveResponse)(nil), "proton.feepolicy.v1.MsgRemoveChannelIncentiveResponse")
+}
+
+func init() { proto.RegisterFile("proton/feepolicy/v1/tx.proto", fileDescriptor_c4d1893eed4bd5df) }
+
+var fileDescriptor_c4d1893eed4bd5df = []byte{
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x53, 0xbf, 0x6f, 0x1a, 0x31,
+	0x14, 0xe6, 0xa0, 0xa1, 0xc2, 0x99, 0x7a, 0x89, 0x12, 0x40, 0xe9, 0x85, 0x50, 0x55, 0x42, 0x54,
+	0xd8, 0x82, 0x28, 0x51, 0x9b, 0xad, 0x44, 0xaa, 0xc4, 0xc0, 0x42, 0xb6, 0x2e, 0xd1, 0xfd, 0xf0,
+	0x1d, 0x56, 0x39, 0xfb, 0x74, 0x36, 0xd7, 0xb0, 0x45, 0x9d, 0xaa, 0x4e, 0xfd, 0x33, 0xaa, 0x4e,
+	0x0c, 0xfd, 0x17, 0x2a, 0x65, 0x8c, 0x3a, 0x75, 0x6a, 0x2b, 0xa8, 0xc4, 0xbf, 0x51, 0xf9, 0x6c,
+	0x02, 0x52, 0x8f, 0x21, 0x53, 0x96, 0x3b, 0xfb, 0x7d, 0xdf, 0x7b, 0xfe, 0xde, 0xe7, 0x67, 0x70,
+	0x10, 0xc5, 0x4c, 0x30, 0x8a, 0x7c, 0x8c, 0x23, 0x36, 0x22, 0xee, 0x04, 0x25, 0x6d, 0x24, 0xae,
+	0x60, 0x1a, 0x36, 0x77, 0x14, 0x0a, 0xef, 0x50, 0x98, 0xb4, 0xab, 0xfb, 0x2e, 0xe3, 0x21, 0xe3,
+	0x28, 0xe4, 0x81, 0x24, 0x87, 0x3c, 0x50, 0xec, 0xea, 0x13, 0x3b, 0x24, 0x94, 0xa1, 0xf4, 0xab,
+	0x43, 0xbb, 0x01, 0x0b, 0x58, 0xba, 0x44, 0x72, 0xa5, 0xa3, 0x15, 0x55, 0xe1, 0x52, 0x01, 0x6a,
+	0xa3, 0x21, 0x4b, 0x17, 0x77, 0x6c, 0x8e, 0x51, 0xd2, 0x76, 0xb0, 0xb0, 0xdb, 0xc8, 0x65, 0x84,
+	0x6a, 0xfc, 0x88, 0x38, 0x2e, 0xb2, 0xa3, 0x68, 0x44, 0x5c, 0x5b, 0x10, 0x46, 0xb9, 0x54, 0x2e,
+	0x65, 0xf8, 0x18, 0x2b, 0x4a, 0xfd, 0x6f, 0x1e, 0xec, 0xf5, 0x79, 0x70, 0x81, 0xc5, 0xf9, 0xd0,
+	0xa6, 0x14, 0x8f, 0x7a, 0xd4, 0xc5, 0x54, 0x90, 0x04, 0x9b, 0xa7, 0xa0, 0x64, 0x8f, 0xc5, 0x90,
+	0xc5, 0x44, 0x4c, 0xca, 0x46, 0xcd, 0x68, 0x94, 0xba, 0xe5, 0x1f, 0xdf, 0x5a, 0xbb, 0x5a, 0xc2,
+	0x6b, 0xcf, 0x8b, 0x31, 0xe7, 0x17, 0x22, 0x26, 0x34, 0x18, 0xac, 0xa8, 0xe6, 0x3e, 0x78, 0x1c,
+	0xb1, 0x58, 0x5c, 0x12, 0xaf, 0x9c, 0x97, 0x59, 0x83, 0xa2, 0xdc, 0xf6, 0x3c, 0xf3, 0x29, 0x00,
+	0xae, 0x3a, 0x44, 0x62, 0x85, 0x14, 0x2b, 0xe9, 0x48, 0xcf, 0x33, 0x5f, 0x81, 0x82, 0x8f, 0x71,
+	0xf9, 0x51, 0xcd, 0x68, 0x6c, 0x77, 0x0e, 0x20, 0x71, 0x5c, 0xb8, 0xae, 0x5d, 0xfa, 0x0a, 0x93,
+	0x36, 0x7c, 0x83, 0x71, 0xb7, 0x74, 0xf3, 0xeb, 0x30, 0xf7, 0x65, 0x31, 0x6d, 0x1a, 0x03, 0x99,
+	0x63, 0x0e, 0x41, 0xd1, 0x0e, 0xd9, 0x98, 0x8a, 0xf2, 0x56, 0xad, 0xd0, 0xd8, 0xee, 0x54, 0xa0,
+	0x16, 0x29, 0x9d, 0x81, 0xda, 0x19, 0x78, 0xce, 0x08, 0xed, 0x9e, 0xc8, 0xd4, 0xaf, 0xbf, 0x0f,
+	0x1b, 0x01, 0x11, 0xc3, 0xb1, 0x03, 0x5d, 0x16, 0x6a, 0x53, 0xf5, 0xaf, 0xc5, 0xbd, 0x77, 0x48,
+	0x4c, 0x22, 0xcc, 0xd3, 0x04, 0xae, 0x8e, 0xd1, 0xf5, 0xcf, 0x4e, 0x3e, 0x2c, 0xa6, 0xcd, 0x55,
+	0xb3, 0x9f, 0x16, 0xd3, 0x66, 0x5d, 0x4f, 0xc5, 0xd5, 0xda, 0x5c, 0x28, 0x4b, 0xef, 0xbc, 0xac,
+	0xd7, 0x80, 0x95, 0xed, 0xf2, 0x00, 0xf3, 0x88, 0x51, 0x8e, 0xeb, 0xdf, 0x0d, 0x50, 0xe9, 0xf3,
+	0x60, 0x80, 0x43, 0x96, 0xe0, 0x87, 0xbe, 0x8b, 0xb3, 0x97, 0xff, 0xb7, 0xf9, 0x3c, 0xbb, 0x4d,
+	0x25, 0x78, 0xd5, 0xe9, 0x33, 0x70, 0xb4, 0xb1, 0x8d, 0x65, 0xb3, 0x9d, 0x8f, 0x79, 0x50, 0xe8,
+	0xf3, 0xc0, 0x7c, 0x0f, 0x76, 0xb2, 0x26, 0xef, 0x05, 0xcc, 0x78, 0x4a, 0x30, 0xdb, 0xc0, 0xea,
+	0xf1, 0x3d, 0xc8, 0x4b, 0x01, 0xe6, 0xb5, 0x01, 0xf6, 0x36, 0x58, 0x0d, 0x37, 0xd5, 0xcb, 0xe6,
+	0x57, 0x4f, 0xef, 0xc7, 0x5f, 0x4a, 0xa8, 0x6e, 0x5d, 0xcb, 0xc1, 0xea, 0xf6, 0x6e, 0x66, 0x96,
+	0x71, 0x3b, 0xb3, 0x8c, 0x3f, 0x33, 0xcb, 0xf8, 0x3c, 0xb7, 0x72, 0xb7, 0x73, 0x2b, 0xf7, 0x73,
+	0x6e, 0xe5, 0xde, 0xa2, 0xb5, 0x09, 0xf5, 0x6d, 0x61, 0x8f, 0x5a, 0x7e, 0x3c, 0x26, 0x02, 0x65,
+	0xdc, 0x43, 0x3a, 0xae, 0x4e, 0x31, 0x45, 0x8e, 0xff, 0x0d, 0x00, 0x94, 0xe8, 0x93, 0xce, 0xa7,
+	0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetChannelIncentive defines a governance operation for setting the relayer
+	// fee attached to the packets sent over a channel and funding its budget
+	// from the community pool. The authority defaults to the x/gov module
+	// account.
+	SetChannelIncentive(ctx context.Context, in *MsgSetChannelIncentive, opts ...grpc.CallOption) (*MsgSetChannelIncentiveResponse, error)
+	// RemoveChannelIncentive defines a governance operation for removing the
+	// incentive of a channel, returning its budget to the community pool.
+	RemoveChannelIncentive(ctx context.Context, in *MsgRemoveChannelIncentive, opts ...grpc.CallOption) (*MsgRemoveChannelIncentiveResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetChannelIncentive(ctx context.Context, in *MsgSetChannelIncentive, opts ...grpc.CallOption) (*MsgSetChannelIncentiveResponse, error) {
+	out := new(MsgSetChannelIncentiveResponse)
+	err := c.cc.Invoke(ctx, "/proton.feepolicy.v1.Msg/SetChannelIncentive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveChannelIncentive(ctx context.Context, in *MsgRemoveChannelIncentive, opts ...grpc.CallOption) (*MsgRemoveChannelIncentiveResponse, error) {
+	out := new(MsgRemoveChannelIncentiveResponse)
+	err := c.cc.Invoke(ctx, "/proton.feepolicy.v1.Msg/RemoveChannelIncentive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetChannelIncentive defines a governance operation for setting the relayer
+	// fee attached to the packets sent over a channel and funding its budget
+	// from the community pool. The authority defaults to the x/gov module
+	// account.
+	SetChannelIncentive(context.Context, *MsgSetChannelIncentive) (*MsgSetChannelIncentiveResponse, error)
+	// RemoveChannelIncentive defines a governance operation for removing the
+	// incentive of a channel, returning its budget to the community pool.
+	RemoveChannelIncentive(context.Context, *MsgRemoveChannelIncentive) (*MsgRemoveChannelIncentiveResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetChannelIncentive(ctx context.Context, req *MsgSetChannelIncentive) (*MsgSetChannelIncentiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelIncentive not implemented")
+}
+func (*UnimplementedMsgServer) RemoveChannelIncentive(ctx context.Context, req *MsgRemoveChannelIncentive) (*MsgRemoveChannelIncentiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChannelIncentive not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetChannelIncentive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChannelIncentive)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChannelIncentive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proton.feepolicy.v1.Msg/SetChannelIncentive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChannelIncentive(ctx, req.(*MsgSetChannelIncentive))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveChannelIncentive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveChannelIncentive)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveChannelIncentive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proton.feepolicy.v1.Msg/RemoveChannelIncentive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveChannelIncentive(ctx, req.(*MsgRemoveChannelIncentive))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proton.feepolicy.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetChannelIncentive",
+			Handler:    _Msg_SetChannelIncentive_Handler,
+		},
+		{
+			MethodName: "RemoveChannelIncentive",
+			Handler:    _Msg_RemoveChannelIncentive_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proton/feepolicy/v1/tx.proto",
+}
+
+func (m *MsgSetChannelIncentive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChannelIncentive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChannelIncentiveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChannelIncentiveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelIncentiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveChannelIncentive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveChannelIncentive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveChannelIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveChannelIncentiveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveChannelIncentiveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveChannelIncentiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetChannelIncentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetChannelIncentiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveChannelIncentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveChannelIncentiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetChannelIncentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChannelIncentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChannelIncentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetChannelIncentiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChannelIncentiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChannelIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveChannelIncentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChannelIncentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChannelIncentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveChannelIncentiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChannelIncentiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChannelIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
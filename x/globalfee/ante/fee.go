@@ -0,0 +1,80 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/fatal-fruit/proton/x/globalfee/types"
+)
+
+// GlobalFeeKeeper defines the expected x/globalfee keeper.
+type GlobalFeeKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	IsBypassTx(ctx sdk.Context, msgs []sdk.Msg, gas uint64) bool
+}
+
+// GlobalFeeDecorator enforces the governance set minimum gas prices. Unlike
+// the node local minimum gas prices, they are checked in both CheckTx and
+// DeliverTx. Txs which only carry bypass messages are exempt as long as they
+// stay under the bypass gas limit; above it they pay the minimum fee like any
+// other tx, or fail with ErrBypassGasExceeded.
+type GlobalFeeDecorator struct {
+	keeper GlobalFeeKeeper
+}
+
+func NewGlobalFeeDecorator(k GlobalFeeKeeper) GlobalFeeDecorator {
+	return GlobalFeeDecorator{
+		keeper: k,
+	}
+}
+
+func (gfd GlobalFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// fees are not known yet when estimating gas
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	params := gfd.keeper.GetParams(ctx)
+	gas := feeTx.GetGas()
+
+	onlyBypassMsgs := params.ContainsOnlyBypassMsgs(feeTx.GetMsgs())
+	if onlyBypassMsgs && gas <= params.MaxTotalBypassMinFeeMsgGasUsage {
+		return next(ctx, tx, simulate)
+	}
+
+	requiredFees := RequiredFees(params.MinimumGasPrices, gas)
+	if requiredFees.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	if !feeTx.GetFee().IsAnyGTE(requiredFees) {
+		if onlyBypassMsgs {
+			return ctx, errorsmod.Wrapf(types.ErrBypassGasExceeded, "gas limit %d exceeds %d and got: %s required at least one of: %s", gas, params.MaxTotalBypassMinFeeMsgGasUsage, feeTx.GetFee(), requiredFees)
+		}
+		return ctx, errorsmod.Wrapf(types.ErrInsufficientFee, "got: %s required at least one of: %s", feeTx.GetFee(), requiredFees)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// RequiredFees returns the fees a tx with the given gas limit has to pay in
+// each denom of the minimum gas prices, rounded up.
+func RequiredFees(minGasPrices sdk.DecCoins, gas uint64) sdk.Coins {
+	glDec := sdk.NewDec(int64(gas))
+
+	requiredFees := make(sdk.Coins, 0, len(minGasPrices))
+	for _, gp := range minGasPrices {
+		fee := gp.Amount.Mul(glDec)
+		if fee.IsPositive() {
+			requiredFees = append(requiredFees, sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt()))
+		}
+	}
+
+	return requiredFees.Sort()
+}
@@ -0,0 +1,99 @@
+package ante_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/x/globalfee/ante"
+	"github.com/fatal-fruit/proton/x/globalfee/types"
+)
+
+func TestGlobalFeeDecorator(t *testing.T) {
+	const maxBypassGas = 1_000_000
+
+	addr := sdk.AccAddress("addr________________")
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1)))
+	recv := &channeltypes.MsgRecvPacket{Signer: addr.String()}
+	update := &clienttypes.MsgUpdateClient{Signer: addr.String()}
+	minGasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(app.BondDenom, sdk.NewDecWithPrec(25, 4)),
+		sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 2)),
+	)
+	fees := func(amount int64, denom string) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+
+	testCases := []struct {
+		name         string
+		minGasPrices sdk.DecCoins
+		msgs         []sdk.Msg
+		gas          uint64
+		fees         sdk.Coins
+		simulate     bool
+		expErr       error
+	}{
+		{"no minimum gas prices", sdk.DecCoins{}, []sdk.Msg{send}, 200_000, nil, false, nil},
+		{"fee meets the minimum", minGasPrices, []sdk.Msg{send}, 200_000, fees(500, app.BondDenom), false, nil},
+		{"fee meets the minimum of another denom", minGasPrices, []sdk.Msg{send}, 200_000, fees(2000, "uatom"), false, nil},
+		{"fee below the minimum", minGasPrices, []sdk.Msg{send}, 200_000, fees(499, app.BondDenom), false, types.ErrInsufficientFee},
+		{"no fee", minGasPrices, []sdk.Msg{send}, 200_000, nil, false, types.ErrInsufficientFee},
+		{"fee rounded up to the next unit", minGasPrices, []sdk.Msg{send}, 100_001, fees(250, app.BondDenom), false, types.ErrInsufficientFee},
+		{"simulation skips the check", minGasPrices, []sdk.Msg{send}, 200_000, nil, true, nil},
+		{"bypass msgs under the gas limit without fee", minGasPrices, []sdk.Msg{update, recv}, maxBypassGas, nil, false, nil},
+		{"bypass msgs over the gas limit with the minimum fee", minGasPrices, []sdk.Msg{update, recv}, maxBypassGas + 1, fees(2501, app.BondDenom), false, nil},
+		{"bypass msgs over the gas limit without fee", minGasPrices, []sdk.Msg{update, recv}, maxBypassGas + 1, nil, false, types.ErrBypassGasExceeded},
+		{"bypass msgs over the gas limit below the minimum fee", minGasPrices, []sdk.Msg{update, recv}, maxBypassGas + 1, fees(2500, app.BondDenom), false, types.ErrBypassGasExceeded},
+		{"bypass msgs mixed with other msgs without fee", minGasPrices, []sdk.Msg{recv, send}, 200_000, nil, false, types.ErrInsufficientFee},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			encodingConfig := app.RegisterEncodingConfig()
+			protonApp := app.Setup(t)
+			ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+			params := types.NewParams(tc.minGasPrices, types.DefaultBypassMinFeeMsgTypes, maxBypassGas)
+			require.NoError(t, protonApp.GlobalFeeKeeper.SetParams(ctx, params))
+
+			txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+			txBuilder.SetGasLimit(tc.gas)
+			txBuilder.SetFeeAmount(tc.fees)
+
+			nextCalled := false
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			}
+
+			_, err := ante.NewGlobalFeeDecorator(protonApp.GlobalFeeKeeper).AnteHandle(ctx, txBuilder.GetTx(), tc.simulate, next)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.False(t, nextCalled)
+			} else {
+				require.NoError(t, err)
+				require.True(t, nextCalled)
+			}
+		})
+	}
+}
+
+func TestRequiredFees(t *testing.T) {
+	minGasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 2)),
+		sdk.NewDecCoinFromDec(app.BondDenom, sdk.NewDecWithPrec(25, 4)),
+	)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1001), sdk.NewInt64Coin(app.BondDenom, 251)), ante.RequiredFees(minGasPrices, 100_001))
+	require.True(t, ante.RequiredFees(minGasPrices, 0).IsZero())
+	require.True(t, ante.RequiredFees(sdk.DecCoins{}, 100_000).IsZero())
+}
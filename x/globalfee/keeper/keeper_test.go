@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/x/globalfee/keeper"
+	"github.com/fatal-fruit/proton/x/globalfee/types"
+)
+
+func setup(t *testing.T) (*app.ProtonApp, sdk.Context) {
+	t.Helper()
+
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	return protonApp, ctx
+}
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.Params
+		expErr bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{"minimum gas prices", types.NewParams(sdk.NewDecCoins(sdk.NewDecCoinFromDec(app.BondDenom, sdk.NewDecWithPrec(25, 4))), nil, 0), false},
+		{"unsorted minimum gas prices", types.NewParams(sdk.DecCoins{
+			sdk.NewDecCoinFromDec(app.BondDenom, sdk.OneDec()),
+			sdk.NewDecCoinFromDec("uatom", sdk.OneDec()),
+		}, nil, 0), true},
+		{"zero minimum gas price", types.NewParams(sdk.DecCoins{sdk.NewDecCoinFromDec(app.BondDenom, sdk.ZeroDec())}, nil, 0), true},
+		{"empty bypass message type", types.NewParams(sdk.DecCoins{}, []string{""}, 0), true},
+		{"duplicate bypass message type", types.NewParams(sdk.DecCoins{}, []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}, 0), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisAndQueries(t *testing.T) {
+	protonApp, ctx := setup(t)
+	k := protonApp.GlobalFeeKeeper
+
+	genesis := types.NewGenesisState(types.NewParams(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(app.BondDenom, sdk.NewDecWithPrec(25, 4))),
+		types.DefaultBypassMinFeeMsgTypes,
+		500_000,
+	))
+	require.NoError(t, types.ValidateGenesis(*genesis))
+
+	k.InitGenesis(ctx, genesis)
+	require.Equal(t, genesis, k.ExportGenesis(ctx))
+	require.True(t, k.GetParams(ctx).IsBypassMsgType(types.DefaultBypassMinFeeMsgTypes[0]))
+	require.False(t, k.GetParams(ctx).IsBypassMsgType("/cosmos.bank.v1beta1.MsgSend"))
+
+	res, err := k.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, genesis.Params, res.Params)
+
+	require.Error(t, k.SetParams(ctx, types.NewParams(sdk.DecCoins{}, []string{""}, 0)))
+	require.Equal(t, genesis.Params, k.GetParams(ctx))
+}
+
+func TestUpdateParams(t *testing.T) {
+	protonApp, ctx := setup(t)
+	k := protonApp.GlobalFeeKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.NewParams(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(app.BondDenom, sdk.NewDecWithPrec(1, 3))),
+		types.DefaultBypassMinFeeMsgTypes,
+		types.DefaultMaxTotalBypassMinFeeMsgGasUsage,
+	)
+
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: sdk.AccAddress("not_gov_____________").String(),
+		Params:    params,
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    types.NewParams(sdk.DecCoins{}, []string{""}, 0),
+	})
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/globalfee module sentinel errors
+var (
+	ErrInsufficientFee   = errorsmod.Register(ModuleName, 2, "insufficient fee")
+	ErrBypassGasExceeded = errorsmod.Register(ModuleName, 3, "bypass messages gas usage exceeded")
+)
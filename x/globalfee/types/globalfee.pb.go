@@ -0,0 +1,442 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proton/globalfee/v1/globalfee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the set of x/globalfee parameters.
+type Params struct {
+	// minimum_gas_prices is the network wide fee floor. A tx must pay at least
+	// the gas price of one of the listed denoms. An empty list disables the floor.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices"`
+	// bypass_min_fee_msg_types lists the message type URLs, such as IBC relayer
+	// messages, which may be sent without fees.
+	BypassMinFeeMsgTypes []string `protobuf:"bytes,2,rep,name=bypass_min_fee_msg_types,json=bypassMinFeeMsgTypes,proto3" json:"bypass_min_fee_msg_types,omitempty"`
+	// max_total_bypass_min_fee_msg_gas_usage is the maximum gas a tx made only
+	// of bypass messages may use without paying fees.
+	MaxTotalBypassMinFeeMsgGasUsage uint64 `protobuf:"varint,3,opt,name=max_total_bypass_min_fee_msg_gas_usage,json=maxTotalBypassMinFeeMsgGasUsage,proto3" json:"max_total_bypass_min_fee_msg_gas_usage,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_248d2ce04eaeae92, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetBypassMinFeeMsgTypes() []string {
+	if m != nil {
+		return m.BypassMinFeeMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTotalBypassMinFeeMsgGasUsage() uint64 {
+	if m != nil {
+		return m.MaxTotalBypassMinFeeMsgGasUsage
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "proton.globalfee.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("proton/globalfee/v1/globalfee.proto", fileDescriptor_248d2ce04eaeae92)
+}
+
+var fileDescriptor_248d2ce04eaeae92 = []byte{
+	// 376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x3d, 0xcb, 0x13, 0x41,
+	0x10, 0xc7, 0xef, 0x12, 0x09, 0x78, 0x36, 0x7a, 0xa6, 0x38, 0x83, 0x6c, 0x82, 0x82, 0x84, 0x48,
+	0x6e, 0x89, 0x82, 0x88, 0x65, 0x14, 0x83, 0x45, 0x30, 0x84, 0xd8, 0xd8, 0x2c, 0x73, 0xe7, 0xde,
+	0xba, 0x78, 0x7b, 0x7b, 0xdc, 0xec, 0x85, 0xa4, 0xb7, 0xb2, 0xf2, 0x63, 0x88, 0x55, 0x4a, 0x3f,
+	0x42, 0xca, 0x94, 0x56, 0xfa, 0x90, 0x14, 0xf9, 0x1a, 0x0f, 0xf7, 0xf2, 0x90, 0x3c, 0x90, 0x66,
+	0x5f, 0xe6, 0xff, 0x9f, 0xdf, 0x30, 0x33, 0xce, 0xd3, 0x34, 0xd3, 0x46, 0x27, 0x54, 0xc4, 0x3a,
+	0x80, 0x38, 0xe2, 0x9c, 0x2e, 0x47, 0xa7, 0x8f, 0x5f, 0xaa, 0xee, 0xc3, 0xca, 0xe4, 0x9f, 0xe2,
+	0xcb, 0x51, 0xa7, 0x2d, 0xb4, 0xd0, 0xa5, 0x40, 0x8b, 0x57, 0x65, 0xed, 0x3c, 0x00, 0x25, 0x13,
+	0x4d, 0xcb, 0xb3, 0x0e, 0x91, 0x50, 0xa3, 0xd2, 0x48, 0x03, 0xc0, 0x82, 0x1e, 0x70, 0x03, 0x23,
+	0x1a, 0x6a, 0x99, 0x54, 0xfa, 0x93, 0x3f, 0x0d, 0xa7, 0x35, 0x83, 0x0c, 0x14, 0xba, 0xdf, 0x6d,
+	0xc7, 0x55, 0x32, 0x91, 0x2a, 0x57, 0x4c, 0x00, 0xb2, 0x34, 0x93, 0x21, 0x47, 0xcf, 0xee, 0x35,
+	0xfb, 0xf7, 0x5e, 0x3c, 0xf6, 0x2b, 0x90, 0x5f, 0x80, 0xfc, 0x1a, 0xe4, 0xbf, 0xe3, 0xe1, 0x5b,
+	0x2d, 0x93, 0xf1, 0xeb, 0xed, 0xbf, 0xae, 0xf5, 0xfb, 0x7f, 0xf7, 0xb9, 0x90, 0xe6, 0x6b, 0x1e,
+	0xf8, 0xa1, 0x56, 0xb4, 0x2e, 0x5c, 0x5d, 0x43, 0xfc, 0xf2, 0x8d, 0x9a, 0x75, 0xca, 0xf1, 0x26,
+	0x07, 0x7f, 0x1d, 0x37, 0x03, 0x7b, 0x7e, 0xbf, 0xae, 0x38, 0x01, 0x9c, 0x95, 0xf5, 0xdc, 0x57,
+	0x8e, 0x17, 0xac, 0x53, 0x40, 0x64, 0x4a, 0x26, 0x2c, 0xe2, 0x9c, 0x29, 0x14, 0xac, 0xcc, 0xf5,
+	0x1a, 0xbd, 0x66, 0xff, 0xee, 0xbc, 0x5d, 0xe9, 0x53, 0x99, 0xbc, 0xe7, 0x7c, 0x8a, 0x62, 0x51,
+	0x68, 0xee, 0x47, 0xe7, 0x99, 0x82, 0x15, 0x33, 0xda, 0x40, 0xcc, 0x2e, 0x10, 0x8a, 0x96, 0x72,
+	0x04, 0xc1, 0xbd, 0x66, 0xcf, 0xee, 0xdf, 0x99, 0x77, 0x15, 0xac, 0x16, 0x85, 0x79, 0x7c, 0x9b,
+	0x36, 0x01, 0xfc, 0x54, 0xd8, 0xde, 0x90, 0x1f, 0xc7, 0xcd, 0xe0, 0x51, 0xbd, 0xa2, 0xd5, 0xd9,
+	0x92, 0xaa, 0x79, 0x8d, 0x3f, 0x6c, 0xf7, 0xc4, 0xde, 0xed, 0x89, 0x7d, 0xb5, 0x27, 0xf6, 0xcf,
+	0x03, 0xb1, 0x76, 0x07, 0x62, 0xfd, 0x3d, 0x10, 0xeb, 0x33, 0x3d, 0x1b, 0x43, 0x04, 0x06, 0xe2,
+	0x61, 0x94, 0xe5, 0xd2, 0xd0, 0x0b, 0xac, 0xb2, 0xaf, 0xa0, 0x55, 0x2a, 0x2f, 0xaf, 0x07, 0x00,
+	0xdd, 0x45, 0xac, 0x7f, 0x11, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		i = encodeVarintGlobalfee(dAtA, i, uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for iNdEx := len(m.BypassMinFeeMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BypassMinFeeMsgTypes[iNdEx])
+			copy(dAtA[i:], m.BypassMinFeeMsgTypes[iNdEx])
+			i = encodeVarintGlobalfee(dAtA, i, uint64(len(m.BypassMinFeeMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGlobalfee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGlobalfee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGlobalfee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGlobalfee(uint64(l))
+		}
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for _, s := range m.BypassMinFeeMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGlobalfee(uint64(l))
+		}
+	}
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		n += 1 + sovGlobalfee(uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+	}
+	return n
+}
+
+func sovGlobalfee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGlobalfee(x uint64) (n int) {
+	return sovGlobalfee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGlobalfee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMinFeeMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BypassMinFeeMsgTypes = append(m.BypassMinFeeMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalBypassMinFeeMsgGasUsage", wireType)
+			}
+			m.MaxTotalBypassMinFeeMsgGasUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalBypassMinFeeMsgGasUsage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGlobalfee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGlobalfee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGlobalfee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGlobalfee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGlobalfee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGlobalfee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGlobalfee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGlobalfee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGlobalfee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGlobalfee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGlobalfee = fmt.Errorf("proto: unexpected end of group")
+)
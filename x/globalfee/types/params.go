@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// DefaultMaxTotalBypassMinFeeMsgGasUsage is the default gas limit of a tx
+// which only carries bypass messages.
+const DefaultMaxTotalBypassMinFeeMsgGasUsage uint64 = 1_000_000
+
+// DefaultBypassMinFeeMsgTypes are the IBC relayer messages which may be sent
+// without paying fees by default.
+var DefaultBypassMinFeeMsgTypes = []string{
+	sdk.MsgTypeURL(&ibcchanneltypes.MsgRecvPacket{}),
+	sdk.MsgTypeURL(&ibcchanneltypes.MsgAcknowledgement{}),
+	sdk.MsgTypeURL(&ibcclienttypes.MsgUpdateClient{}),
+	sdk.MsgTypeURL(&ibcchanneltypes.MsgTimeout{}),
+	sdk.MsgTypeURL(&ibcchanneltypes.MsgTimeoutOnClose{}),
+}
+
+// NewParams creates a new Params instance.
+func NewParams(minGasPrices sdk.DecCoins, bypassMsgTypes []string, maxBypassGas uint64) Params {
+	return Params{
+		MinimumGasPrices:                minGasPrices,
+		BypassMinFeeMsgTypes:            bypassMsgTypes,
+		MaxTotalBypassMinFeeMsgGasUsage: maxBypassGas,
+	}
+}
+
+// DefaultParams returns the default x/globalfee parameters. No fee floor is
+// set until governance agrees on one.
+func DefaultParams() Params {
+	return NewParams(sdk.DecCoins{}, DefaultBypassMinFeeMsgTypes, DefaultMaxTotalBypassMinFeeMsgGasUsage)
+}
+
+// Validate performs a stateless validation of the parameters.
+func (p Params) Validate() error {
+	if err := p.MinimumGasPrices.Validate(); err != nil {
+		return fmt.Errorf("invalid minimum gas prices: %w", err)
+	}
+
+	seen := make(map[string]bool, len(p.BypassMinFeeMsgTypes))
+	for _, msgType := range p.BypassMinFeeMsgTypes {
+		if msgType == "" {
+			return fmt.Errorf("bypass message type cannot be empty")
+		}
+		if seen[msgType] {
+			return fmt.Errorf("duplicate bypass message type %s", msgType)
+		}
+		seen[msgType] = true
+	}
+
+	return nil
+}
+
+// IsBypassMsgType returns true if msgType may be sent without fees.
+func (p Params) IsBypassMsgType(msgType string) bool {
+	for _, t := range p.BypassMinFeeMsgTypes {
+		if t == msgType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ContainsOnlyBypassMsgs returns true if msgs is not empty and all of its
+// messages may be sent without fees.
+func (p Params) ContainsOnlyBypassMsgs(msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		if !p.IsBypassMsgType(sdk.MsgTypeURL(msg)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsBypassTx returns true if a tx carrying msgs with the given gas limit is
+// exempt from fees: it only carries bypass messages and stays under the
+// bypass gas limit.
+func (p Params) IsBypassTx(msgs []sdk.Msg, gas uint64) bool {
+	return gas <= p.MaxTotalBypassMinFeeMsgGasUsage && p.ContainsOnlyBypassMsgs(msgs)
+}
@@ -0,0 +1,113 @@
+package ibchooks
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/fatal-fruit/proton/x/ibchooks/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware runs the msgs of the hook memo of a received ICS-20 packet
+// once the transfer succeeded. The tokens are received by the sender derived
+// from the packet, which signs the msgs. Every callback but OnRecvPacket goes
+// to the wrapped transfer app unchanged.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	cdc    codec.Codec
+	router types.MessageRouter
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping app, executing hook
+// msgs through router.
+func NewIBCMiddleware(app porttypes.IBCModule, cdc codec.Codec, router types.MessageRouter) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		cdc:       cdc,
+		router:    router,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. Packets without a hook are
+// passed on as is. Core IBC only commits the state changes of a packet with a
+// successful acknowledgement, so a failing hook msg reverts the transfer too.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	msgs, ok, err := types.ParseMemo(im.cdc, data.Memo)
+	if !ok {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// the derived sender receives the tokens, so the hook msgs can spend them
+	sender := types.DeriveSender(packet.DestinationChannel, data.Sender)
+	data.Receiver = sender.String()
+	packet.Data = data.GetBytes()
+
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	for i, msg := range msgs {
+		if err := im.executeMsg(ctx, sender, msg); err != nil {
+			return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(err, "hook msg %d", i))
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeHook,
+		sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyChannelID, packet.DestinationChannel),
+		sdk.NewAttribute(types.AttributeKeyMsgCount, strconv.Itoa(len(msgs))),
+	))
+
+	return ack
+}
+
+// executeMsg executes msg, which must be signed by sender alone.
+func (im IBCMiddleware) executeMsg(ctx sdk.Context, sender sdk.AccAddress, msg sdk.Msg) error {
+	signers := msg.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(sender) {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "expected %s, got %v", sender, signers)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	handler := im.router.Handler(msg)
+	if handler == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no handler for %s", sdk.MsgTypeURL(msg))
+	}
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrHookFailed, err.Error())
+	}
+
+	// the msg handler runs with a new event manager, propagate its events
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	return nil
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/ibchooks module sentinel errors
+var (
+	ErrInvalidMemo   = errorsmod.Register(ModuleName, 2, "invalid hook memo")
+	ErrInvalidSigner = errorsmod.Register(ModuleName, 3, "hook msg must be signed by the derived sender only")
+	ErrHookFailed    = errorsmod.Register(ModuleName, 4, "hook msg failed")
+)
@@ -0,0 +1,10 @@
+package types
+
+// x/ibchooks module event types
+const (
+	EventTypeHook = "ibc_hook"
+
+	AttributeKeySender    = "sender"
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeyMsgCount  = "msg_count"
+)
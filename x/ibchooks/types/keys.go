@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "ibchooks"
+
+	// MemoKey is the key of the hook in the memo of an ICS-20 packet.
+	MemoKey = "hooks"
+)
+
+// DeriveSender returns the local account acting for sender of a packet
+// received on channelID. It is derived from the channel the packet arrived on,
+// which uniquely identifies the counterparty chain, so a remote sender can
+// never act as a local account or as a sender of another chain.
+func DeriveSender(channelID, sender string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(channelID+"/"+sender))
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HookMemo is the hook of an ICS-20 memo, set under MemoKey:
+//
+//	{"hooks":{"msgs":[{"@type":"/cosmos.staking.v1beta1.MsgDelegate",...}]}}
+//
+// Its msgs are executed in order, signed by the derived sender, once the
+// transferred tokens were received by the derived sender.
+type HookMemo struct {
+	Msgs []json.RawMessage `json:"msgs"`
+}
+
+// ParseMemo returns the hook msgs of memo. It returns false if the memo holds
+// no hook and an error if the hook is malformed.
+func ParseMemo(cdc codec.JSONCodec, memo string) ([]sdk.Msg, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		// plain text memos are not hooks
+		return nil, false, nil
+	}
+
+	raw, ok := fields[MemoKey]
+	if !ok {
+		return nil, false, nil
+	}
+
+	var hook HookMemo
+	if err := json.Unmarshal(raw, &hook); err != nil {
+		return nil, true, errorsmod.Wrap(ErrInvalidMemo, err.Error())
+	}
+	if len(hook.Msgs) == 0 {
+		return nil, true, errorsmod.Wrap(ErrInvalidMemo, "no msgs")
+	}
+
+	msgs := make([]sdk.Msg, len(hook.Msgs))
+	for i, rawMsg := range hook.Msgs {
+		if err := cdc.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+			return nil, true, errorsmod.Wrapf(ErrInvalidMemo, "msg %d: %v", i, err)
+		}
+	}
+
+	return msgs, true, nil
+}
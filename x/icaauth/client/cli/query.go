@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/fatal-fruit/proton/x/icaauth/types"
+)
+
+const flagOwner = "owner"
+
+// GetQueryCmd returns the cli query commands for the icaauth module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the icaauth module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryInterchainAccount(),
+		GetCmdQueryPacket(),
+		GetCmdQueryPackets(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryInterchainAccount implements a command to return the interchain
+// account address of an owner.
+func GetCmdQueryInterchainAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-account [owner] [connection-id]",
+		Short:   "Query the interchain account address of an owner on the host chain of a connection",
+		Example: "protond query icaauth interchain-account proton1... connection-0",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InterchainAccount(cmd.Context(), &types.QueryInterchainAccountRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPacket implements a command to return the record of a packet.
+func GetCmdQueryPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet [channel-id] [sequence]",
+		Short:   "Query the status of a packet sent for an interchain account",
+		Example: "protond query icaauth packet channel-0 1",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Packet(cmd.Context(), &types.QueryPacketRequest{
+				ChannelId: args[0],
+				Sequence:  sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Packet)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPackets implements a command to return the packet records.
+func GetCmdQueryPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packets",
+		Short:   "Query the packets sent for interchain accounts",
+		Example: "protond query icaauth packets --owner proton1...",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Packets(cmd.Context(), &types.QueryPacketsRequest{
+				Owner:      owner,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "Only return the packets of this owner")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packets")
+
+	return cmd
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	"github.com/spf13/cobra"
+
+	"github.com/fatal-fruit/proton/x/icaauth/types"
+)
+
+const (
+	flagVersion         = "version"
+	flagRelativeTimeout = "relative-packet-timeout"
+)
+
+// GetTxCmd returns the transaction commands for the icaauth module.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Interchain accounts transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		GetCmdRegisterInterchainAccount(),
+		GetCmdSubmitTx(),
+	)
+
+	return txCmd
+}
+
+// GetCmdRegisterInterchainAccount implements a command to register an
+// interchain account of the signer.
+func GetCmdRegisterInterchainAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register [connection-id]",
+		Short:   "Register an interchain account of the signer on the host chain of a connection",
+		Example: "protond tx icaauth register connection-0 --from mykey",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := cmd.Flags().GetString(flagVersion)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterInterchainAccount(clientCtx.GetFromAddress().String(), args[0], version)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagVersion, "", "Interchain account channel version, negotiated if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitTx implements a command to execute msgs with the interchain
+// account of the signer.
+func GetCmdSubmitTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-tx [connection-id] [path/to/msgs.json]",
+		Short: "Execute msgs with the interchain account of the signer on the host chain of a connection",
+		Long: `Execute msgs with the interchain account of the signer. The file holds a
+single JSON encoded msg or an array of them, using the addresses of the host chain.`,
+		Example: "protond tx icaauth submit-tx connection-0 msgs.json --from mykey",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := parseMsgs(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			relativeTimeout, err := cmd.Flags().GetUint64(flagRelativeTimeout)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitTx(clientCtx.GetFromAddress().String(), args[0], msgs, relativeTimeout)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagRelativeTimeout, icatypes.DefaultRelativePacketTimeoutTimestamp, "Packet timeout in nanoseconds from now")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseMsgs reads a single JSON encoded msg or an array of them from path.
+func parseMsgs(cdc codec.Codec, path string) ([]sdk.Msg, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal(bz, &rawMsgs); err != nil {
+		rawMsgs = []json.RawMessage{bz}
+	}
+
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		if err := cdc.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("invalid msg %d: %w", i, err)
+		}
+	}
+
+	return msgs, nil
+}
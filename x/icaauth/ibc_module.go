@@ -0,0 +1,141 @@
+package icaauth
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/fatal-fruit/proton/x/icaauth/keeper"
+	"github.com/fatal-fruit/proton/x/icaauth/types"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule is the authentication module of the ICS-27 controller stack. The
+// controller middleware handles the channel handshake and calls back into it
+// for the accounts registered through x/icaauth.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface. The channel capability
+// is claimed by the controller middleware.
+func (im IBCModule) OnChanOpenInit(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_ string,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCModule) OnChanOpenTry(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	_ string,
+) (string, error) {
+	return "", errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCModule) OnChanOpenAck(
+	_ sdk.Context,
+	_,
+	_ string,
+	_ string,
+	_ string,
+) error {
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanOpenConfirm(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im IBCModule) OnChanCloseInit(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanCloseConfirm(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. A controller chain never
+// receives packets.
+func (im IBCModule) OnRecvPacket(
+	_ sdk.Context,
+	_ channeltypes.Packet,
+	_ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive packet on controller chain"))
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. It records
+// whether the host chain executed the msgs of the packet.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 packet acknowledgement: %v", err)
+	}
+
+	if ack.Success() {
+		im.keeper.SetPacketStatus(ctx, packet.SourceChannel, packet.Sequence, types.PacketStatusAcknowledged, "")
+	} else {
+		im.keeper.SetPacketStatus(ctx, packet.SourceChannel, packet.Sequence, types.PacketStatusFailed, ack.GetError())
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The ordered channel of
+// the account is closed on timeout and must be registered again.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) error {
+	im.keeper.SetPacketStatus(ctx, packet.SourceChannel, packet.Sequence, types.PacketStatusTimedOut, "")
+	return nil
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/icaauth/types"
+)
+
+// InitGenesis new icaauth genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	for _, record := range data.Packets {
+		k.SetPacket(ctx, record)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllPackets(ctx))
+}
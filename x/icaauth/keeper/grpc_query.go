@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/fatal-fruit/proton/x/icaauth/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// InterchainAccount returns the interchain account address of an owner.
+func (k Keeper) InterchainAccount(c context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, found := k.GetInterchainAccountAddress(ctx, req.Owner, req.ConnectionId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no interchain account for owner %s on connection %s", req.Owner, req.ConnectionId)
+	}
+
+	return &types.QueryInterchainAccountResponse{Address: address}, nil
+}
+
+// Packet returns the record of a packet.
+func (k Keeper) Packet(c context.Context, req *types.QueryPacketRequest) (*types.QueryPacketResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record, found := k.GetPacket(ctx, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrPacketNotFound, "packet %d on channel %s", req.Sequence, req.ChannelId)
+	}
+
+	return &types.QueryPacketResponse{Packet: record}, nil
+}
+
+// Packets returns the packet records, optionally filtered by owner.
+func (k Keeper) Packets(c context.Context, req *types.QueryPacketsRequest) (*types.QueryPacketsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var records []types.PacketRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PacketPrefix)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var record types.PacketRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return false, err
+		}
+
+		if req.Owner != "" && record.Owner != req.Owner {
+			return false, nil
+		}
+
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPacketsResponse{Packets: records, Pagination: pageRes}, nil
+}
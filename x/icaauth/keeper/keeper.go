@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+
+	"github.com/fatal-fruit/proton/x/icaauth/types"
+)
+
+// Keeper of the x/icaauth store
+type Keeper struct {
+	cdc      codec.Codec
+	storeKey storetypes.StoreKey
+
+	icaControllerKeeper types.ICAControllerKeeper
+}
+
+// NewKeeper creates a new x/icaauth Keeper instance. The codec must be a
+// ProtoCodec, as interchain account txs are proto encoded.
+func NewKeeper(cdc codec.Codec, key storetypes.StoreKey, icaControllerKeeper types.ICAControllerKeeper) Keeper {
+	return Keeper{
+		cdc:                 cdc,
+		storeKey:            key,
+		icaControllerKeeper: icaControllerKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// RegisterInterchainAccount opens an interchain account channel for owner on
+// connectionID and returns the controller port of the account. The channel
+// handshake completes once relayed; the account address is known after that.
+func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, owner, connectionID, version string) (string, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	if err := k.icaControllerKeeper.RegisterInterchainAccount(ctx, connectionID, owner, version); err != nil {
+		return "", err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterInterchainAccount,
+		sdk.NewAttribute(types.AttributeKeyOwner, owner),
+		sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+	))
+
+	return portID, nil
+}
+
+// GetInterchainAccountAddress returns the interchain account address of owner
+// on connectionID.
+func (k Keeper) GetInterchainAccountAddress(ctx sdk.Context, owner, connectionID string) (string, bool) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", false
+	}
+
+	return k.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+}
+
+// SubmitTx sends msgs to be executed by the interchain account of owner on
+// connectionID and tracks the packet until it is acknowledged or times out.
+func (k Keeper) SubmitTx(ctx sdk.Context, owner, connectionID string, msgs []sdk.Msg, relativeTimeout uint64) (types.PacketRecord, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return types.PacketRecord{}, err
+	}
+
+	channelID, found := k.icaControllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return types.PacketRecord{}, errorsmod.Wrapf(types.ErrNoActiveChannel, "owner %s on connection %s", owner, connectionID)
+	}
+
+	protoMsgs := make([]proto.Message, len(msgs))
+	for i, msg := range msgs {
+		protoMsgs[i] = msg
+	}
+	data, err := icatypes.SerializeCosmosTx(k.cdc, protoMsgs)
+	if err != nil {
+		return types.PacketRecord{}, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + relativeTimeout
+	sequence, err := k.icaControllerKeeper.SendTx(ctx, nil, connectionID, portID, packetData, timeoutTimestamp)
+	if err != nil {
+		return types.PacketRecord{}, err
+	}
+
+	record := types.PacketRecord{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		ChannelId:    channelID,
+		Sequence:     sequence,
+		Status:       types.PacketStatusPending,
+	}
+	k.SetPacket(ctx, record)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSubmitTx,
+		sdk.NewAttribute(types.AttributeKeyOwner, owner),
+		sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+	))
+
+	return record, nil
+}
+
+// SetPacket stores a packet record.
+func (k Keeper) SetPacket(ctx sdk.Context, record types.PacketRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PacketKey(record.ChannelId, record.Sequence), k.cdc.MustMarshal(&record))
+}
+
+// GetPacket returns the record of the packet of sequence on channelID.
+func (k Keeper) GetPacket(ctx sdk.Context, channelID string, sequence uint64) (types.PacketRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketKey(channelID, sequence))
+	if bz == nil {
+		return types.PacketRecord{}, false
+	}
+
+	var record types.PacketRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// IteratePackets iterates over all packet records. The iteration stops when
+// cb returns true.
+func (k Keeper) IteratePackets(ctx sdk.Context, cb func(record types.PacketRecord) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PacketPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.PacketRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllPackets returns all packet records.
+func (k Keeper) GetAllPackets(ctx sdk.Context) []types.PacketRecord {
+	records := []types.PacketRecord{}
+	k.IteratePackets(ctx, func(record types.PacketRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+// SetPacketStatus updates the status of a tracked packet. Packets which were
+// not sent through this module are ignored.
+func (k Keeper) SetPacketStatus(ctx sdk.Context, channelID string, sequence uint64, status types.PacketStatus, ackErr string) {
+	record, found := k.GetPacket(ctx, channelID, sequence)
+	if !found {
+		return
+	}
+
+	record.Status = status
+	record.Error = ackErr
+	k.SetPacket(ctx, record)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePacketStatus,
+		sdk.NewAttribute(types.AttributeKeyOwner, record.Owner),
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+		sdk.NewAttribute(types.AttributeKeyError, ackErr),
+	))
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/x/icaauth/types"
+)
+
+func setup(t *testing.T) (*app.ProtonApp, sdk.Context) {
+	t.Helper()
+
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	return protonApp, ctx
+}
+
+func TestSubmitTxWithoutChannel(t *testing.T) {
+	protonApp, ctx := setup(t)
+	owner := sdk.AccAddress("owner_______________")
+
+	send := banktypes.NewMsgSend(owner, owner, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	_, err := protonApp.ICAAuthKeeper.SubmitTx(ctx, owner.String(), "connection-0", []sdk.Msg{send}, 0)
+	require.ErrorIs(t, err, types.ErrNoActiveChannel)
+	require.Empty(t, protonApp.ICAAuthKeeper.GetAllPackets(ctx))
+}
+
+func TestPacketsGenesisAndQueries(t *testing.T) {
+	protonApp, ctx := setup(t)
+	k := protonApp.ICAAuthKeeper
+
+	alice := sdk.AccAddress("alice_______________").String()
+	bob := sdk.AccAddress("bob_________________").String()
+	genesis := types.NewGenesisState([]types.PacketRecord{
+		{Owner: alice, ConnectionId: "connection-0", ChannelId: "channel-0", Sequence: 1, Status: types.PacketStatusAcknowledged},
+		{Owner: alice, ConnectionId: "connection-0", ChannelId: "channel-0", Sequence: 2, Status: types.PacketStatusPending},
+		{Owner: bob, ConnectionId: "connection-0", ChannelId: "channel-1", Sequence: 1, Status: types.PacketStatusFailed, Error: "ABCI code: 5"},
+	})
+	require.NoError(t, types.ValidateGenesis(*genesis))
+
+	k.InitGenesis(ctx, genesis)
+	require.Equal(t, genesis, k.ExportGenesis(ctx))
+
+	k.SetPacketStatus(ctx, "channel-0", 2, types.PacketStatusTimedOut, "")
+	record, found := k.GetPacket(ctx, "channel-0", 2)
+	require.True(t, found)
+	require.Equal(t, types.PacketStatusTimedOut, record.Status)
+
+	res, err := k.Packets(sdk.WrapSDKContext(ctx), &types.QueryPacketsRequest{Owner: alice})
+	require.NoError(t, err)
+	require.Len(t, res.Packets, 2)
+
+	res, err = k.Packets(sdk.WrapSDKContext(ctx), &types.QueryPacketsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Packets, 3)
+
+	_, err = k.Packet(sdk.WrapSDKContext(ctx), &types.QueryPacketRequest{ChannelId: "channel-1", Sequence: 2})
+	require.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/icaauth/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the x/icaauth MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterInterchainAccount opens an interchain account channel for the owner.
+func (k msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := k.Keeper.RegisterInterchainAccount(ctx, msg.Owner, msg.ConnectionId, msg.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterInterchainAccountResponse{PortId: portID}, nil
+}
+
+// SubmitTx sends the msgs to the interchain account of the owner.
+func (k msgServer) SubmitTx(goCtx context.Context, msg *types.MsgSubmitTx) (*types.MsgSubmitTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := k.Keeper.SubmitTx(ctx, msg.Owner, msg.ConnectionId, msgs, msg.RelativeTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitTxResponse{ChannelId: record.ChannelId, Sequence: record.Sequence}, nil
+}
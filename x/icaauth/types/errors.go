@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/icaauth module sentinel errors
+var (
+	ErrNoActiveChannel = errorsmod.Register(ModuleName, 2, "no active interchain account channel")
+	ErrPacketNotFound  = errorsmod.Register(ModuleName, 3, "packet not found")
+)
@@ -0,0 +1,15 @@
+package types
+
+// x/icaauth module event types
+const (
+	EventTypeRegisterInterchainAccount = "register_interchain_account"
+	EventTypeSubmitTx                  = "submit_interchain_tx"
+	EventTypePacketStatus              = "interchain_packet_status"
+
+	AttributeKeyOwner        = "owner"
+	AttributeKeyConnectionID = "connection_id"
+	AttributeKeyChannelID    = "channel_id"
+	AttributeKeySequence     = "sequence"
+	AttributeKeyStatus       = "status"
+	AttributeKeyError        = "error"
+)
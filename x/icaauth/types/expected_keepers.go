@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+)
+
+// ICAControllerKeeper defines the expected ICS-27 controller keeper.
+type ICAControllerKeeper interface {
+	RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string) error
+	SendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error)
+	GetActiveChannelID(ctx sdk.Context, connectionID, portID string) (string, bool)
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
+}
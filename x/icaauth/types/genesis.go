@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(packets []PacketRecord) *GenesisState {
+	return &GenesisState{
+		Packets: packets,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]PacketRecord{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool, len(data.Packets))
+	for _, packet := range data.Packets {
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+
+		key := string(PacketKey(packet.ChannelId, packet.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate packet %d on channel %s", packet.Sequence, packet.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the packet record.
+func (p PacketRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	if err := host.ConnectionIdentifierValidator(p.ConnectionId); err != nil {
+		return err
+	}
+	if !channeltypes.IsValidChannelID(p.ChannelId) {
+		return fmt.Errorf("invalid channel identifier %s", p.ChannelId)
+	}
+	if p.Sequence == 0 {
+		return fmt.Errorf("packet sequence cannot be 0")
+	}
+	if _, ok := PacketStatus_name[int32(p.Status)]; !ok || p.Status == PacketStatusUnspecified {
+		return fmt.Errorf("invalid packet status %s", p.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,611 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proton/icaauth/v1/icaauth.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PacketStatus is the status of an interchain account packet.
+type PacketStatus int32
+
+const (
+	// PACKET_STATUS_UNSPECIFIED defines the default packet status.
+	PacketStatusUnspecified PacketStatus = 0
+	// PACKET_STATUS_PENDING defines a sent packet awaiting its acknowledgement.
+	PacketStatusPending PacketStatus = 1
+	// PACKET_STATUS_ACKNOWLEDGED defines a packet whose msgs were executed by
+	// the host chain.
+	PacketStatusAcknowledged PacketStatus = 2
+	// PACKET_STATUS_FAILED defines a packet acknowledged with an error by the
+	// host chain.
+	PacketStatusFailed PacketStatus = 3
+	// PACKET_STATUS_TIMED_OUT defines a packet which timed out before it was
+	// received by the host chain.
+	PacketStatusTimedOut PacketStatus = 4
+)
+
+var PacketStatus_name = map[int32]string{
+	0: "PACKET_STATUS_UNSPECIFIED",
+	1: "PACKET_STATUS_PENDING",
+	2: "PACKET_STATUS_ACKNOWLEDGED",
+	3: "PACKET_STATUS_FAILED",
+	4: "PACKET_STATUS_TIMED_OUT",
+}
+
+var PacketStatus_value = map[string]int32{
+	"PACKET_STATUS_UNSPECIFIED":  0,
+	"PACKET_STATUS_PENDING":      1,
+	"PACKET_STATUS_ACKNOWLEDGED": 2,
+	"PACKET_STATUS_FAILED":       3,
+	"PACKET_STATUS_TIMED_OUT":    4,
+}
+
+func (x PacketStatus) String() string {
+	return proto.EnumName(PacketStatus_name, int32(x))
+}
+
+func (PacketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d6b243f6f5cd72b, []int{0}
+}
+
+// PacketRecord tracks a packet sent for an interchain account.
+type PacketRecord struct {
+	// owner is the address owning the interchain account.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// connection_id is the connection to the host chain.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// channel_id is the interchain account channel the packet was sent on.
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the packet sequence on channel_id.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// status is the status of the packet.
+	Status PacketStatus `protobuf:"varint,5,opt,name=status,proto3,enum=proton.icaauth.v1.PacketStatus" json:"status,omitempty"`
+	// error is the error acknowledgement of a failed packet.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *PacketRecord) Reset()         { *m = PacketRecord{} }
+func (m *PacketRecord) String() string { return proto.CompactTextString(m) }
+func (*PacketRecord) ProtoMessage()    {}
+func (*PacketRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6b243f6f5cd72b, []int{0}
+}
+func (m *PacketRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketRecord.Merge(m, src)
+}
+func (m *PacketRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketRecord proto.InternalMessageInfo
+
+func (m *PacketRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PacketRecord) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *PacketRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketRecord) GetStatus() PacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PacketStatusUnspecified
+}
+
+func (m *PacketRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("proton.icaauth.v1.PacketStatus", PacketStatus_name, PacketStatus_value)
+	proto.RegisterType((*PacketRecord)(nil), "proton.icaauth.v1.PacketRecord")
+}
+
+func init() { proto.RegisterFile("proton/icaauth/v1/icaauth.proto", fileDescriptor_2d6b243f6f5cd72b) }
+
+var fileDescriptor_2d6b243f6f5cd72b = []byte{
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0x8e, 0xd2, 0x40,
+	0x18, 0xc7, 0xe9, 0x2e, 0x10, 0x77, 0x82, 0x06, 0x47, 0x94, 0xee, 0xa8, 0xdd, 0x46, 0x2f, 0xc4,
+	0x84, 0xe2, 0xae, 0x31, 0x26, 0xc6, 0x4b, 0x97, 0x16, 0xd2, 0xec, 0x0a, 0x04, 0x4a, 0x4c, 0xbc,
+	0x90, 0xee, 0xcc, 0x50, 0x26, 0x0b, 0x33, 0xd8, 0x4e, 0x77, 0xf5, 0x0d, 0x0c, 0x27, 0x5f, 0xa0,
+	0x27, 0x8f, 0x5e, 0x7d, 0x08, 0x8f, 0x1b, 0x4f, 0x1e, 0x0d, 0x9c, 0x7d, 0x07, 0x43, 0xcb, 0xae,
+	0x25, 0xde, 0xe6, 0xfb, 0xff, 0x7f, 0xbf, 0x4c, 0xbe, 0xe4, 0x03, 0x07, 0xf3, 0x40, 0x48, 0xc1,
+	0x1b, 0x0c, 0x7b, 0x5e, 0x24, 0x27, 0x8d, 0x8b, 0xc3, 0xeb, 0xa7, 0x91, 0x34, 0xf0, 0x6e, 0x0a,
+	0x18, 0xd7, 0xe9, 0xc5, 0x21, 0xaa, 0xf8, 0xc2, 0x17, 0x49, 0xdc, 0x58, 0xbf, 0x52, 0x10, 0xed,
+	0x63, 0x11, 0xce, 0x44, 0x38, 0x4a, 0x8b, 0x74, 0x48, 0xab, 0x27, 0x7f, 0x14, 0x50, 0xea, 0x79,
+	0xf8, 0x9c, 0xca, 0x3e, 0xc5, 0x22, 0x20, 0xd0, 0x00, 0x05, 0x71, 0xc9, 0x69, 0xa0, 0x2a, 0xba,
+	0x52, 0xdb, 0x3b, 0x56, 0x7f, 0x7e, 0xaf, 0x57, 0x36, 0x86, 0x49, 0x48, 0x40, 0xc3, 0x70, 0x20,
+	0x03, 0xc6, 0xfd, 0x7e, 0x8a, 0xc1, 0xa7, 0xe0, 0x36, 0x16, 0x9c, 0x53, 0x2c, 0x99, 0xe0, 0x23,
+	0x46, 0xd4, 0x9d, 0xb5, 0xd7, 0x2f, 0xfd, 0x0b, 0x1d, 0x02, 0x1f, 0x03, 0x80, 0x27, 0x1e, 0xe7,
+	0x74, 0xba, 0x26, 0x76, 0x13, 0x62, 0x6f, 0x93, 0x38, 0x04, 0x22, 0x70, 0x2b, 0xa4, 0x1f, 0x22,
+	0xca, 0x31, 0x55, 0xf3, 0xba, 0x52, 0xcb, 0xf7, 0x6f, 0x66, 0xf8, 0x0a, 0x14, 0x43, 0xe9, 0xc9,
+	0x28, 0x54, 0x0b, 0xba, 0x52, 0xbb, 0x73, 0x74, 0x60, 0xfc, 0xb7, 0xb5, 0x91, 0x2e, 0x30, 0x48,
+	0xb0, 0xfe, 0x06, 0x87, 0x15, 0x50, 0xa0, 0x41, 0x20, 0x02, 0xb5, 0x98, 0x7c, 0x97, 0x0e, 0xcf,
+	0xbe, 0xed, 0x80, 0x52, 0x16, 0x87, 0xaf, 0xc1, 0x7e, 0xcf, 0x6c, 0x9e, 0xd8, 0xee, 0x68, 0xe0,
+	0x9a, 0xee, 0x70, 0x30, 0x1a, 0x76, 0x06, 0x3d, 0xbb, 0xe9, 0xb4, 0x1c, 0xdb, 0x2a, 0xe7, 0xd0,
+	0xc3, 0x45, 0xac, 0x57, 0xb3, 0xc2, 0x90, 0x87, 0x73, 0x8a, 0xd9, 0x98, 0x51, 0x02, 0x8f, 0xc0,
+	0xfd, 0x6d, 0xb7, 0x67, 0x77, 0x2c, 0xa7, 0xd3, 0x2e, 0x2b, 0xa8, 0xba, 0x88, 0xf5, 0x7b, 0x59,
+	0xaf, 0x47, 0x39, 0x61, 0xdc, 0x87, 0x6f, 0x00, 0xda, 0x76, 0xcc, 0xe6, 0x49, 0xa7, 0xfb, 0xee,
+	0xd4, 0xb6, 0xda, 0xb6, 0x55, 0xde, 0x41, 0x8f, 0x16, 0xb1, 0xae, 0x66, 0x45, 0x13, 0x9f, 0x73,
+	0x71, 0x39, 0xa5, 0xc4, 0xa7, 0x04, 0x3e, 0x07, 0x95, 0x6d, 0xbb, 0x65, 0x3a, 0xa7, 0xb6, 0x55,
+	0xde, 0x45, 0x0f, 0x16, 0xb1, 0x0e, 0xb3, 0x5e, 0xcb, 0x63, 0x53, 0x4a, 0xe0, 0x4b, 0x50, 0xdd,
+	0x36, 0x5c, 0xe7, 0xad, 0x6d, 0x8d, 0xba, 0x43, 0xb7, 0x9c, 0x47, 0xea, 0x22, 0xd6, 0x2b, 0x59,
+	0xc9, 0x65, 0x33, 0x4a, 0xba, 0x91, 0x44, 0xf9, 0xcf, 0x5f, 0xb5, 0xdc, 0x71, 0xfb, 0xc7, 0x52,
+	0x53, 0xae, 0x96, 0x9a, 0xf2, 0x7b, 0xa9, 0x29, 0x5f, 0x56, 0x5a, 0xee, 0x6a, 0xa5, 0xe5, 0x7e,
+	0xad, 0xb4, 0xdc, 0xfb, 0xba, 0xcf, 0xe4, 0x24, 0x3a, 0x33, 0xb0, 0x98, 0x35, 0xc6, 0x9e, 0xf4,
+	0xa6, 0xf5, 0x71, 0x10, 0x31, 0xd9, 0xd8, 0xdc, 0xec, 0xc7, 0x9b, 0xab, 0x95, 0x9f, 0xe6, 0x34,
+	0x3c, 0x2b, 0x26, 0xf9, 0x8b, 0xbf, 0x03, 0x00, 0x25, 0x3e, 0x2d, 0xa6, 0xd4, 0x02, 0x00, 0x00,
+}
+
+func (m *PacketRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintIcaauth(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintIcaauth(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintIcaauth(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIcaauth(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintIcaauth(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintIcaauth(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIcaauth(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIcaauth(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PacketRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovIcaauth(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovIcaauth(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIcaauth(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovIcaauth(uint64(m.Sequence))
+	}
+	if m.Status != 0 {
+		n += 1 + sovIcaauth(uint64(m.Status))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovIcaauth(uint64(l))
+	}
+	return n
+}
+
+func sovIcaauth(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIcaauth(x uint64) (n int) {
+	return sovIcaauth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PacketRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaauth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaauth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaauth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIcaauth(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIcaauth
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaauth
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaauth
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIcaauth
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIcaauth
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIcaauth
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIcaauth        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIcaauth          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIcaauth = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "icaauth"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+// PacketPrefix is the store prefix of the packet records, keyed by channel and
+// sequence.
+var PacketPrefix = []byte{0x01}
+
+// PacketChannelPrefix returns the store prefix of the packet records of
+// channelID.
+func PacketChannelPrefix(channelID string) []byte {
+	return append(append(PacketPrefix, []byte(channelID)...), '/')
+}
+
+// PacketKey returns the store key of the packet record of sequence on
+// channelID.
+func PacketKey(channelID string, sequence uint64) []byte {
+	return append(PacketChannelPrefix(channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
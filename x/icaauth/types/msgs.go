@@ -0,0 +1,123 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterInterchainAccount{}
+	_ sdk.Msg = &MsgSubmitTx{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgSubmitTx{}
+)
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount instance.
+func NewMsgRegisterInterchainAccount(owner, connectionID, version string) *MsgRegisterInterchainAccount {
+	return &MsgRegisterInterchainAccount{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		Version:      version,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterInterchainAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRegisterInterchainAccount message.
+func (m *MsgRegisterInterchainAccount) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRegisterInterchainAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+
+	return host.ConnectionIdentifierValidator(m.ConnectionId)
+}
+
+// NewMsgSubmitTx creates a new MsgSubmitTx instance.
+func NewMsgSubmitTx(owner, connectionID string, msgs []sdk.Msg, relativeTimeout uint64) (*MsgSubmitTx, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return &MsgSubmitTx{
+		Owner:           owner,
+		ConnectionId:    connectionID,
+		Msgs:            anys,
+		RelativeTimeout: relativeTimeout,
+	}, nil
+}
+
+// GetMessages returns the cached messages to execute on the host chain.
+func (m *MsgSubmitTx) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(m.Msgs))
+	for i, any := range m.Msgs {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "message contains %T which is not a sdk.Msg", any.GetCachedValue())
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (m MsgSubmitTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range m.Msgs {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSubmitTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSubmitTx message.
+func (m *MsgSubmitTx) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data. The messages are
+// validated by the host chain, as their addresses use its bech32 prefix.
+func (m *MsgSubmitTx) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+
+	if err := host.ConnectionIdentifierValidator(m.ConnectionId); err != nil {
+		return err
+	}
+
+	if len(m.Msgs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msgs cannot be empty")
+	}
+
+	if m.RelativeTimeout == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "relative timeout cannot be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/icq/types"
+)
+
+// InitGenesis new icq genesis. The host port is bound unless the capability
+// of the port was restored from the capability genesis.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	k.SetPort(ctx, data.HostPort)
+
+	if !k.IsBound(ctx, data.HostPort) {
+		if err := k.BindPort(ctx, data.HostPort); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetPort(ctx), k.GetParams(ctx))
+}
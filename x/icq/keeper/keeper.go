@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/fatal-fruit/proton/x/icq/types"
+)
+
+// Keeper of the x/icq store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeKey     storetypes.StoreKey
+	portKeeper   types.PortKeeper
+	scopedKeeper capabilitykeeper.ScopedKeeper
+	queryRouter  types.QueryRouter
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new x/icq Keeper instance. The queries of received
+// packets are routed through queryRouter.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	portKeeper types.PortKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
+	queryRouter types.QueryRouter,
+	authority string,
+) Keeper {
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     key,
+		portKeeper:   portKeeper,
+		scopedKeeper: scopedKeeper,
+		queryRouter:  queryRouter,
+		authority:    authority,
+	}
+}
+
+// GetAuthority returns the x/icq module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/icq module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/icq module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// GetPort returns the port the host module is bound to.
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	return string(ctx.KVStore(k.storeKey).Get(types.PortKey))
+}
+
+// SetPort stores the port the host module is bound to.
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	ctx.KVStore(k.storeKey).Set(types.PortKey, []byte(portID))
+}
+
+// IsBound returns true if the host module owns the capability of portID.
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort binds portID and claims its capability.
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// AuthenticateCapability wraps the scoped keeper's AuthenticateCapability.
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, capability, name)
+}
+
+// ClaimCapability claims the channel or port capability passed by the IBC
+// core module.
+func (k Keeper) ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, capability, name)
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/x/icq/types"
+)
+
+func setup(t *testing.T) (*app.ProtonApp, sdk.Context) {
+	t.Helper()
+
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	return protonApp, ctx
+}
+
+func TestGenesis(t *testing.T) {
+	protonApp, ctx := setup(t)
+	k := protonApp.ICQKeeper
+
+	genesis := types.NewGenesisState(types.PortID, types.NewParams("/cosmos.bank.v1beta1.Query/Balance"))
+	require.NoError(t, types.ValidateGenesis(*genesis))
+
+	k.InitGenesis(ctx, genesis)
+	require.True(t, k.IsBound(ctx, types.PortID))
+	require.Equal(t, genesis, k.ExportGenesis(ctx))
+
+	invalid := []types.Params{
+		types.NewParams("cosmos.bank.v1beta1.Query/Balance"),
+		types.NewParams("/cosmos.bank.v1beta1.Query/Balance "),
+		types.NewParams("/cosmos.bank.v1beta1.Query/Balance", "/cosmos.bank.v1beta1.Query/Balance"),
+	}
+	for _, params := range invalid {
+		require.Error(t, k.SetParams(ctx, params))
+	}
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	protonApp, ctx := setup(t)
+	k := protonApp.ICQKeeper
+
+	unroutable := "/proton.unknown.v1.Query/Unknown"
+	require.NoError(t, k.SetParams(ctx, types.NewParams(unroutable)))
+
+	query := func(reqs ...abci.RequestQuery) channeltypes.Packet {
+		bz, err := types.SerializeCosmosQuery(reqs)
+		require.NoError(t, err)
+		return channeltypes.Packet{Data: types.InterchainQueryPacketData{Data: bz}.GetBytes()}
+	}
+
+	testCases := []struct {
+		name   string
+		packet channeltypes.Packet
+	}{
+		{"malformed packet data", channeltypes.Packet{Data: []byte("query")}},
+		{"empty packet data", channeltypes.Packet{Data: types.InterchainQueryPacketData{}.GetBytes()}},
+		{"malformed query", channeltypes.Packet{Data: types.InterchainQueryPacketData{Data: []byte{0xff}}.GetBytes()}},
+		{"no queries", query()},
+		{"allowed query without route", query(abci.RequestQuery{Path: unroutable})},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := k.OnRecvPacket(ctx, tc.packet)
+			require.ErrorIs(t, err, types.ErrInvalidQuery)
+		})
+	}
+}
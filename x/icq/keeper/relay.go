@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/fatal-fruit/proton/x/icq/types"
+)
+
+// OnRecvPacket runs the queries of an interchain query packet and returns
+// their encoded CosmosResponse. The packet fails as a whole if any query is
+// not allowed or fails.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	var data types.InterchainQueryPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidQuery, "cannot unmarshal interchain query packet data: %s", err)
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	reqs, err := types.DeserializeCosmosQuery(data.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	resps, err := k.executeQueries(ctx, reqs)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := types.SerializeCosmosResponse(resps)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ModuleCdc.MustMarshalJSON(&types.InterchainQueryPacketAck{Data: bz}), nil
+}
+
+// executeQueries runs reqs against the latest state through the query
+// router. Queries may only read the current height and cannot request
+// proofs, as the host cannot produce them within a block.
+func (k Keeper) executeQueries(ctx sdk.Context, reqs []abci.RequestQuery) ([]abci.ResponseQuery, error) {
+	if len(reqs) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidQuery, "no queries")
+	}
+
+	params := k.GetParams(ctx)
+	resps := make([]abci.ResponseQuery, len(reqs))
+	for i, req := range reqs {
+		if !params.IsAllowedQuery(req.Path) {
+			return nil, errorsmod.Wrap(types.ErrQueryNotAllowed, req.Path)
+		}
+		if req.Height != 0 {
+			return nil, errorsmod.Wrap(types.ErrInvalidQuery, "query height must be zero")
+		}
+		if req.Prove {
+			return nil, errorsmod.Wrap(types.ErrInvalidQuery, "query proofs are not supported")
+		}
+
+		route := k.queryRouter.Route(req.Path)
+		if route == nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidQuery, "no route for %s", req.Path)
+		}
+
+		// the query runs on a branch of the state so that it cannot write to it
+		cacheCtx, _ := ctx.CacheContext()
+		res, err := route(cacheCtx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resps[i] = res
+	}
+
+	return resps, nil
+}
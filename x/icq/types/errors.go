@@ -0,0 +1,13 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/icq module sentinel errors
+var (
+	ErrInvalidChannelFlow = errorsmod.Register(ModuleName, 2, "invalid message sent to channel end")
+	ErrInvalidVersion     = errorsmod.Register(ModuleName, 3, "invalid interchain query version")
+	ErrInvalidQuery       = errorsmod.Register(ModuleName, 4, "invalid interchain query")
+	ErrQueryNotAllowed    = errorsmod.Register(ModuleName, 5, "query path not allowed")
+)
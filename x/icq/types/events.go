@@ -0,0 +1,10 @@
+package types
+
+// x/icq module event types
+const (
+	EventTypeQuery = "interchain_query"
+
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeySequence  = "sequence"
+	AttributeKeySuccess   = "success"
+)
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// PortKeeper defines the expected IBC port keeper
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
+
+// QueryRouter defines the expected gRPC query router, such as the one of
+// BaseApp, the queries of interchain query packets are routed to.
+type QueryRouter interface {
+	Route(path string) baseapp.GRPCQueryHandler
+}
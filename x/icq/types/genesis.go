@@ -0,0 +1,28 @@
+package types
+
+import (
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(hostPort string, params Params) *GenesisState {
+	return &GenesisState{
+		HostPort: hostPort,
+		Params:   params,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(PortID, DefaultParams())
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := host.PortIdentifierValidator(data.HostPort); err != nil {
+		return err
+	}
+
+	return data.Params.Validate()
+}
@@ -0,0 +1,22 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "icq"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// PortID is the default port the host module binds to.
+	PortID = "icqhost"
+
+	// Version defines the current version of the interchain query protocol.
+	Version = "icq-1"
+)
+
+var (
+	// ParamsKey is the store key of the x/icq parameters.
+	ParamsKey = []byte{0x01}
+	// PortKey is the store key of the port bound by the host module.
+	PortKey = []byte{0x02}
+)
@@ -0,0 +1,62 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs a basic check of the packet data.
+func (pd InterchainQueryPacketData) ValidateBasic() error {
+	if len(pd.Data) == 0 {
+		return errorsmod.Wrap(ErrInvalidQuery, "packet data cannot be empty")
+	}
+
+	return nil
+}
+
+// GetBytes returns the sorted JSON encoding of the packet data, as sent over
+// the channel.
+func (pd InterchainQueryPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&pd))
+}
+
+// SerializeCosmosQuery encodes reqs as the data of an interchain query
+// packet.
+func SerializeCosmosQuery(reqs []abci.RequestQuery) ([]byte, error) {
+	q := &CosmosQuery{
+		Requests: reqs,
+	}
+	return q.Marshal()
+}
+
+// DeserializeCosmosQuery decodes the requests of the data of an interchain
+// query packet.
+func DeserializeCosmosQuery(bz []byte) ([]abci.RequestQuery, error) {
+	var q CosmosQuery
+	if err := q.Unmarshal(bz); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidQuery, err.Error())
+	}
+
+	return q.Requests, nil
+}
+
+// SerializeCosmosResponse encodes resps as the data of an interchain query
+// acknowledgement.
+func SerializeCosmosResponse(resps []abci.ResponseQuery) ([]byte, error) {
+	r := &CosmosResponse{
+		Responses: resps,
+	}
+	return r.Marshal()
+}
+
+// DeserializeCosmosResponse decodes the responses of the data of an
+// interchain query acknowledgement.
+func DeserializeCosmosResponse(bz []byte) ([]abci.ResponseQuery, error) {
+	var r CosmosResponse
+	if err := r.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return r.Responses, nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewParams creates a new Params object allowing allowQueries.
+func NewParams(allowQueries ...string) Params {
+	return Params{
+		AllowQueries: allowQueries,
+	}
+}
+
+// DefaultParams returns default x/icq Params, which allow no query.
+func DefaultParams() Params {
+	return NewParams()
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.AllowQueries))
+	for _, path := range p.AllowQueries {
+		if !strings.HasPrefix(path, "/") || strings.TrimSpace(path) != path {
+			return fmt.Errorf("invalid query path %q", path)
+		}
+		if seen[path] {
+			return fmt.Errorf("duplicate query path %s", path)
+		}
+		seen[path] = true
+	}
+
+	return nil
+}
+
+// IsAllowedQuery returns true if path is allowed to be queried.
+func (p Params) IsAllowedQuery(path string) bool {
+	for _, allowed := range p.AllowQueries {
+		if allowed == path {
+			return true
+		}
+	}
+
+	return false
+}
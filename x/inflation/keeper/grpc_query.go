@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/inflation/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the inflation module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Issuance returns the scheduled issuance at the current height.
+func (k Keeper) Issuance(c context.Context, _ *types.QueryIssuanceRequest) (*types.QueryIssuanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	elapsed := ctx.BlockHeight() - k.GetActivationHeight(ctx)
+
+	return &types.QueryIssuanceResponse{
+		AnnualIssuance: params.AnnualIssuance(elapsed),
+		Halvings:       params.Halvings(elapsed),
+		Supply:         k.stakingKeeper.StakingTokenSupply(ctx),
+	}, nil
+}
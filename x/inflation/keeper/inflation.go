@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+var _ minttypes.InflationCalculationFn = Keeper{}.InflationCalculationFn
+
+// InflationCalculationFn returns the inflation rate of the staking supply
+// x/mint applies at the current block. It follows the issuance schedule once
+// enabled, the bonded ratio curve of x/mint otherwise.
+func (k Keeper) InflationCalculationFn(ctx sdk.Context, minter minttypes.Minter, params minttypes.Params, bondedRatio sdk.Dec) sdk.Dec {
+	schedule := k.GetParams(ctx)
+	if !schedule.Enabled {
+		return minttypes.DefaultInflationCalculationFn(ctx, minter, params, bondedRatio)
+	}
+
+	return schedule.Inflation(ctx.BlockHeight()-k.GetActivationHeight(ctx), k.stakingKeeper.StakingTokenSupply(ctx), params.BlocksPerYear)
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/inflation/types"
+)
+
+// Keeper of the x/inflation store
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	stakingKeeper types.StakingKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new x/inflation Keeper instance.
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, sk types.StakingKeeper, authority string) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		stakingKeeper: sk,
+		authority:     authority,
+	}
+}
+
+// GetAuthority returns the x/inflation module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/inflation module parameters. Enabling the schedule
+// records the current height as its activation height.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	if params.Enabled && !k.GetParams(ctx).Enabled {
+		k.SetActivationHeight(ctx, ctx.BlockHeight())
+	}
+
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/inflation module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetActivationHeight sets the height from which the halvings of the
+// schedule count.
+func (k Keeper) SetActivationHeight(ctx sdk.Context, height int64) {
+	ctx.KVStore(k.storeKey).Set(types.ActivationHeightKey, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetActivationHeight returns the height at which the schedule was last
+// enabled.
+func (k Keeper) GetActivationHeight(ctx sdk.Context) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.ActivationHeightKey)
+	if bz == nil {
+		return 0
+	}
+
+	return int64(sdk.BigEndianToUint64(bz))
+}
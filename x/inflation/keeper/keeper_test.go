@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/x/inflation/keeper"
+	"github.com/fatal-fruit/proton/x/inflation/types"
+)
+
+func setup(t *testing.T, height int64) (*app.ProtonApp, sdk.Context) {
+	t.Helper()
+
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: height})
+
+	return protonApp, ctx
+}
+
+// drawParams draws valid parameters issuing up to 10^15 a year.
+func drawParams(t *rapid.T, supplyCap sdkmath.Int) types.Params {
+	initial := rapid.Int64Range(0, 1e15).Draw(t, "initial")
+	tail := rapid.Int64Range(0, initial).Draw(t, "tail")
+	interval := rapid.Uint64Range(1, 1000).Draw(t, "interval")
+
+	return types.NewParams(true, sdkmath.NewInt(initial), interval, sdkmath.NewInt(tail), supplyCap)
+}
+
+func TestSupplyNeverExceedsCap(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		supply := sdkmath.NewInt(rapid.Int64Range(1, 1e15).Draw(t, "supply"))
+		supplyCap := supply.Add(sdkmath.NewInt(rapid.Int64Range(0, 1e9).Draw(t, "headroom")))
+		if rapid.Bool().Draw(t, "overCap") {
+			// the supply already exceeds a cap lowered by governance
+			supplyCap = sdkmath.NewInt(rapid.Int64Range(1, supply.Int64()).Draw(t, "cap"))
+		}
+		params := drawParams(t, supplyCap)
+		require.NoError(t, params.Validate())
+
+		mintParams := minttypes.DefaultParams()
+		mintParams.BlocksPerYear = rapid.Uint64Range(1, 10_000).Draw(t, "blocksPerYear")
+		start := rapid.Int64Range(1, 10_000).Draw(t, "start")
+		blocks := rapid.Int64Range(1, 500).Draw(t, "blocks")
+		limit := sdkmath.MaxInt(supplyCap, supply)
+
+		// mint blocks the way the x/mint BeginBlocker does
+		minter := minttypes.DefaultInitialMinter()
+		for height := start; height < start+blocks; height++ {
+			minter.Inflation = params.Inflation(height, supply, mintParams.BlocksPerYear)
+			require.False(t, minter.Inflation.IsNegative())
+
+			minter.AnnualProvisions = minter.NextAnnualProvisions(mintParams, supply)
+			provision := minter.BlockProvision(mintParams)
+			require.False(t, provision.Amount.IsNegative())
+			require.True(t, provision.Amount.LTE(params.AnnualIssuance(height)))
+
+			supply = supply.Add(provision.Amount)
+			require.True(t, supply.LTE(limit), "supply %s exceeds %s at height %d", supply, limit, height)
+		}
+	})
+}
+
+func TestAnnualIssuanceHalves(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		params := drawParams(t, sdkmath.NewInt(1))
+		height := rapid.Int64Range(0, 1e6).Draw(t, "height")
+		later := height + rapid.Int64Range(0, 1e6).Draw(t, "delta")
+
+		issuance := params.AnnualIssuance(height)
+		require.True(t, issuance.GTE(params.TailIssuance))
+		require.True(t, issuance.LTE(params.InitialIssuance))
+		require.True(t, params.AnnualIssuance(later).LTE(issuance))
+
+		// the next halving halves the issuance, unless the tail takes over
+		next := int64(params.Halvings(height)+1) * int64(params.HalvingInterval)
+		halved := params.AnnualIssuance(next)
+		expected := sdkmath.MaxInt(issuance.QuoRaw(2), params.TailIssuance)
+		require.True(t, expected.Equal(halved), "expected %s, got %s", expected, halved)
+	})
+}
+
+func TestAnnualIssuance(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   types.Params
+		height   int64
+		expected int64
+	}{
+		{"single unit before halving", types.NewParams(true, sdkmath.NewInt(1), 1, sdkmath.ZeroInt(), sdkmath.NewInt(1)), 0, 1},
+		{"single unit halved to zero", types.NewParams(true, sdkmath.NewInt(1), 1, sdkmath.ZeroInt(), sdkmath.NewInt(1)), 1, 0},
+		{"first halving", types.NewParams(true, sdkmath.NewInt(1000), 10, sdkmath.NewInt(100), sdkmath.NewInt(1)), 10, 500},
+		{"second halving", types.NewParams(true, sdkmath.NewInt(1000), 10, sdkmath.NewInt(100), sdkmath.NewInt(1)), 29, 250},
+		{"tail emission", types.NewParams(true, sdkmath.NewInt(1000), 10, sdkmath.NewInt(100), sdkmath.NewInt(1)), 40, 100},
+		{"halvings beyond the issuance bits", types.NewParams(true, sdkmath.NewInt(1000), 1, sdkmath.NewInt(1), sdkmath.NewInt(1)), 1000, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			issuance := tc.params.AnnualIssuance(tc.height)
+			require.True(t, sdkmath.NewInt(tc.expected).Equal(issuance), "expected %d, got %s", tc.expected, issuance)
+		})
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.HalvingInterval = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.TailIssuance = params.InitialIssuance.AddRaw(1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.SupplyCap = sdkmath.ZeroInt()
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.InitialIssuance = sdkmath.NewInt(-1)
+	require.Error(t, params.Validate())
+}
+
+func TestInflationCalculationFn(t *testing.T) {
+	protonApp, ctx := setup(t, 10)
+	k := protonApp.InflationKeeper
+
+	genesis := k.ExportGenesis(ctx)
+	require.Equal(t, types.DefaultGenesisState(), genesis)
+
+	// disabled, x/mint keeps its bonded ratio curve
+	minter := protonApp.MintKeeper.GetMinter(ctx)
+	mintParams := protonApp.MintKeeper.GetParams(ctx)
+	bondedRatio := protonApp.StakingKeeper.BondedRatio(ctx)
+	require.Equal(t,
+		minttypes.DefaultInflationCalculationFn(ctx, minter, mintParams, bondedRatio),
+		k.InflationCalculationFn(ctx, minter, mintParams, bondedRatio),
+	)
+
+	// enabled with 10 tokens of headroom, minting never crosses the cap
+	supply := protonApp.StakingKeeper.StakingTokenSupply(ctx)
+	supplyCap := supply.AddRaw(10)
+	params := types.NewParams(true, sdkmath.NewInt(1e15), 5, sdkmath.ZeroInt(), supplyCap)
+	k.InitGenesis(ctx, types.NewGenesisState(params, 0))
+	require.Equal(t, params, k.GetParams(ctx))
+	require.Equal(t,
+		params.Inflation(ctx.BlockHeight(), supply, mintParams.BlocksPerYear),
+		k.InflationCalculationFn(ctx, minter, mintParams, bondedRatio),
+	)
+
+	for i := 0; i < 3; i++ {
+		mint.BeginBlocker(ctx, protonApp.MintKeeper, k.InflationCalculationFn)
+		require.True(t, protonApp.StakingKeeper.StakingTokenSupply(ctx).LTE(supplyCap))
+	}
+	require.True(t, protonApp.StakingKeeper.StakingTokenSupply(ctx).GT(supply))
+
+	res, err := k.Issuance(sdk.WrapSDKContext(ctx), &types.QueryIssuanceRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Halvings)
+	require.Equal(t, sdkmath.NewInt(1e15/4), res.AnnualIssuance)
+	require.Equal(t, protonApp.StakingKeeper.StakingTokenSupply(ctx), res.Supply)
+
+	require.Error(t, k.SetParams(ctx, types.Params{}))
+}
+
+func TestActivationHeight(t *testing.T) {
+	protonApp, ctx := setup(t, 10)
+	k := protonApp.InflationKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+
+	// enabling the schedule starts its halvings at the current height
+	params := types.NewParams(true, sdkmath.NewInt(1e15), 5, sdkmath.ZeroInt(), sdkmath.NewInt(1e18))
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), k.GetActivationHeight(ctx))
+
+	res, err := k.Issuance(sdk.WrapSDKContext(ctx), &types.QueryIssuanceRequest{})
+	require.NoError(t, err)
+	require.Zero(t, res.Halvings)
+	require.Equal(t, params.InitialIssuance, res.AnnualIssuance)
+
+	// updating the enabled schedule keeps its activation height
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, types.NewGenesisState(params, 10), k.ExportGenesis(ctx))
+
+	res, err = k.Issuance(sdk.WrapSDKContext(ctx), &types.QueryIssuanceRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Halvings)
+
+	// enabling it again restarts the halvings
+	params.Enabled = false
+	require.NoError(t, k.SetParams(ctx, params))
+	params.Enabled = true
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, int64(20), k.GetActivationHeight(ctx))
+
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(params, -1)))
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakingKeeper defines the expected staking keeper used to read the supply
+// x/mint issues on.
+type StakingKeeper interface {
+	StakingTokenSupply(ctx sdk.Context) sdkmath.Int
+}
@@ -0,0 +1,26 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, activationHeight int64) *GenesisState {
+	return &GenesisState{
+		Params:           params,
+		ActivationHeight: activationHeight,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), 0)
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if data.ActivationHeight < 0 {
+		return fmt.Errorf("activation height cannot be negative: %d", data.ActivationHeight)
+	}
+
+	return data.Params.Validate()
+}
@@ -0,0 +1,508 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proton/inflation/v1/inflation.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the set of x/inflation parameters. They schedule the yearly
+// issuance of the mint denom in place of the bonded ratio curve of x/mint.
+type Params struct {
+	// enabled replaces the bonded ratio curve of x/mint with the schedule.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// initial_issuance is the amount issued per year before the first halving.
+	InitialIssuance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=initial_issuance,json=initialIssuance,proto3,customtype=cosmossdk.io/math.Int" json:"initial_issuance"`
+	// halving_interval is the number of blocks after which the yearly issuance
+	// halves.
+	HalvingInterval uint64 `protobuf:"varint,3,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+	// tail_issuance is the amount issued per year once the halvings brought the
+	// issuance below it.
+	TailIssuance cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=tail_issuance,json=tailIssuance,proto3,customtype=cosmossdk.io/math.Int" json:"tail_issuance"`
+	// supply_cap is the total supply never exceeded by issuance.
+	SupplyCap cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=supply_cap,json=supplyCap,proto3,customtype=cosmossdk.io/math.Int" json:"supply_cap"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d12288d8a5934551, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Params) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "proton.inflation.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("proton/inflation/v1/inflation.proto", fileDescriptor_d12288d8a5934551)
+}
+
+var fileDescriptor_d12288d8a5934551 = []byte{
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xb1, 0x6e, 0xe2, 0x30,
+	0x1c, 0xc6, 0x63, 0x8e, 0xe3, 0x0e, 0xeb, 0x4e, 0x70, 0xb9, 0x3b, 0x29, 0x30, 0x04, 0xd4, 0x2e,
+	0x14, 0x89, 0xb8, 0xa8, 0x5b, 0x47, 0x3a, 0x65, 0x6a, 0x85, 0xd4, 0xa5, 0x1d, 0xa2, 0x3f, 0x21,
+	0x04, 0xab, 0x89, 0x1d, 0xc5, 0x4e, 0x54, 0x5e, 0xa1, 0x53, 0x1f, 0xa3, 0x23, 0x43, 0x1f, 0x82,
+	0x11, 0x75, 0xaa, 0x3a, 0xa0, 0x0a, 0x06, 0xf6, 0x3e, 0x41, 0x45, 0x9c, 0x16, 0x86, 0x4e, 0x2c,
+	0x96, 0xff, 0xdf, 0xf7, 0xe9, 0xe7, 0x4f, 0xfe, 0xe3, 0xc3, 0x28, 0xe6, 0x92, 0x33, 0x42, 0xd9,
+	0x28, 0x00, 0x49, 0x39, 0x23, 0x69, 0x77, 0x3b, 0x58, 0x99, 0xab, 0xff, 0x55, 0x21, 0x6b, 0xab,
+	0xa7, 0xdd, 0xfa, 0x3f, 0x9f, 0xfb, 0x3c, 0x33, 0xc8, 0xe6, 0xa6, 0xa2, 0xf5, 0x3f, 0x10, 0x52,
+	0xc6, 0x49, 0x76, 0xe6, 0x52, 0xcd, 0xe5, 0x22, 0xe4, 0xc2, 0x51, 0x59, 0x35, 0x28, 0xeb, 0xe0,
+	0xad, 0x80, 0x4b, 0x17, 0x10, 0x43, 0x28, 0x74, 0x03, 0xff, 0xf0, 0x18, 0x0c, 0x02, 0x6f, 0x68,
+	0xa0, 0x26, 0x6a, 0xfd, 0xec, 0x7f, 0x8c, 0xfa, 0x35, 0xae, 0x52, 0x46, 0x25, 0x85, 0xc0, 0xa1,
+	0x42, 0x24, 0xc0, 0x5c, 0xcf, 0x28, 0x34, 0x51, 0xab, 0xdc, 0x3b, 0x9e, 0x2d, 0x1a, 0xda, 0xcb,
+	0xa2, 0xf1, 0x5f, 0x41, 0xc5, 0xf0, 0xc6, 0xa2, 0x9c, 0x84, 0x20, 0xc7, 0x96, 0xcd, 0xe4, 0xd3,
+	0x63, 0x07, 0xe7, 0xaf, 0xd9, 0x4c, 0x3e, 0xac, 0xa7, 0x6d, 0xd4, 0xaf, 0xe4, 0x24, 0x3b, 0x07,
+	0xe9, 0x47, 0xb8, 0x3a, 0x86, 0x20, 0xa5, 0xcc, 0x77, 0x28, 0x93, 0x5e, 0x9c, 0x42, 0x60, 0x7c,
+	0x6b, 0xa2, 0x56, 0xb1, 0x5f, 0xc9, 0x75, 0x3b, 0x97, 0xf5, 0x4b, 0xfc, 0x5b, 0x02, 0xdd, 0x29,
+	0x51, 0xdc, 0xb3, 0xc4, 0xaf, 0x0d, 0xe6, 0xb3, 0xc1, 0x39, 0xc6, 0x22, 0x89, 0xa2, 0x60, 0xe2,
+	0xb8, 0x10, 0x19, 0xdf, 0xf7, 0x64, 0x96, 0x15, 0xe3, 0x0c, 0xa2, 0x53, 0xf3, 0x6e, 0x3d, 0x6d,
+	0xd7, 0xf2, 0xbd, 0xde, 0xee, 0x6c, 0x56, 0xfd, 0x74, 0xcf, 0x9e, 0x2d, 0x4d, 0x34, 0x5f, 0x9a,
+	0xe8, 0x75, 0x69, 0xa2, 0xfb, 0x95, 0xa9, 0xcd, 0x57, 0xa6, 0xf6, 0xbc, 0x32, 0xb5, 0x2b, 0xe2,
+	0x53, 0x39, 0x4e, 0x06, 0x96, 0xcb, 0x43, 0x32, 0x02, 0x09, 0x41, 0x67, 0x14, 0x27, 0x54, 0x92,
+	0x2f, 0x58, 0x72, 0x12, 0x79, 0x62, 0x50, 0xca, 0x9c, 0x93, 0xf7, 0x01, 0x00, 0xba, 0xfe, 0x24,
+	0x04, 0x46, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SupplyCap.Size()
+		i -= size
+		if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TailIssuance.Size()
+		i -= size
+		if _, err := m.TailIssuance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.HalvingInterval != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.InitialIssuance.Size()
+		i -= size
+		if _, err := m.InitialIssuance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInflation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.InitialIssuance.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	if m.HalvingInterval != 0 {
+		n += 1 + sovInflation(uint64(m.HalvingInterval))
+	}
+	l = m.TailIssuance.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func sovInflation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInflation(x uint64) (n int) {
+	return sovInflation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialIssuance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialIssuance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TailIssuance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TailIssuance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInflation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInflation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInflation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInflation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInflation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInflation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInflation = fmt.Errorf("proto: unexpected end of group")
+)
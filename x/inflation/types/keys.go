@@ -0,0 +1,17 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "inflation"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the store key of the x/inflation parameters.
+	ParamsKey = []byte{0x01}
+	// ActivationHeightKey is the store key of the height at which the
+	// schedule was last enabled.
+	ActivationHeightKey = []byte{0x02}
+)
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params instance.
+func NewParams(enabled bool, initialIssuance sdkmath.Int, halvingInterval uint64, tailIssuance, supplyCap sdkmath.Int) Params {
+	return Params{
+		Enabled:         enabled,
+		InitialIssuance: initialIssuance,
+		HalvingInterval: halvingInterval,
+		TailIssuance:    tailIssuance,
+		SupplyCap:       supplyCap,
+	}
+}
+
+// DefaultParams returns the default x/inflation parameters. The schedule is
+// disabled, x/mint keeps its bonded ratio curve until governance enables it.
+func DefaultParams() Params {
+	return NewParams(
+		false,
+		sdkmath.NewIntWithDecimal(100_000_000, 6),
+		4*6_311_520, // four years of 5s blocks
+		sdkmath.NewIntWithDecimal(1_000_000, 6),
+		sdkmath.NewIntWithDecimal(1_000_000_000, 6),
+	)
+}
+
+// Validate performs a stateless validation of the parameters.
+func (p Params) Validate() error {
+	for name, amount := range map[string]sdkmath.Int{"initial issuance": p.InitialIssuance, "tail issuance": p.TailIssuance} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("%s must be non-negative: %s", name, amount)
+		}
+	}
+	if p.TailIssuance.GT(p.InitialIssuance) {
+		return fmt.Errorf("tail issuance %s exceeds initial issuance %s", p.TailIssuance, p.InitialIssuance)
+	}
+	if p.HalvingInterval == 0 {
+		return fmt.Errorf("halving interval must be positive")
+	}
+	if p.SupplyCap.IsNil() || !p.SupplyCap.IsPositive() {
+		return fmt.Errorf("supply cap must be positive: %s", p.SupplyCap)
+	}
+
+	return nil
+}
+
+// Halvings returns the number of halvings of the issuance elapsed blocks
+// after the activation of the schedule.
+func (p Params) Halvings(elapsed int64) uint64 {
+	if elapsed <= 0 {
+		return 0
+	}
+
+	return uint64(elapsed) / p.HalvingInterval
+}
+
+// AnnualIssuance returns the yearly issuance elapsed blocks after the
+// activation of the schedule: the initial issuance halved every halving
+// interval, never below the tail issuance.
+func (p Params) AnnualIssuance(elapsed int64) sdkmath.Int {
+	halvings := p.Halvings(elapsed)
+	if halvings >= uint64(p.InitialIssuance.BigInt().BitLen()) {
+		return p.TailIssuance
+	}
+
+	issuance := sdkmath.NewIntFromBigInt(new(big.Int).Rsh(p.InitialIssuance.BigInt(), uint(halvings)))
+	return sdkmath.MaxInt(issuance, p.TailIssuance)
+}
+
+// Inflation returns the inflation rate of supply elapsed blocks after the
+// activation of the schedule, x/mint issuing
+// supply times the rate per year over blocksPerYear blocks. The issuance of a
+// block never takes supply over the supply cap.
+func (p Params) Inflation(elapsed int64, supply sdkmath.Int, blocksPerYear uint64) sdk.Dec {
+	if !supply.IsPositive() || blocksPerYear == 0 {
+		return sdk.ZeroDec()
+	}
+
+	remaining := p.SupplyCap.Sub(supply)
+	if !remaining.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	// x/mint issues a truncated blocksPerYear-th of the annual provisions per
+	// block, so a year of remaining per block keeps the block within the cap.
+	issuance := sdkmath.MinInt(p.AnnualIssuance(elapsed), remaining.Mul(sdkmath.NewIntFromUint64(blocksPerYear)))
+
+	// QuoInt truncates, the rate times supply never exceeds the issuance.
+	return sdk.NewDecFromInt(issuance).QuoInt(supply)
+}
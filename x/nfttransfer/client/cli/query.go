@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/fatal-fruit/proton/x/nfttransfer/types"
+)
+
+// GetQueryCmd returns the cli query commands for the nfttransfer module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the nfttransfer module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryClassTrace(),
+		GetCmdQueryClassTraces(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryClassTrace implements a command to return the trace of a
+// voucher class.
+func GetCmdQueryClassTrace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "class-trace [hash|class-id]",
+		Short:   "Query the trace of a voucher class",
+		Example: "protond query nfttransfer class-trace ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClassTrace(cmd.Context(), &types.QueryClassTraceRequest{Hash: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClassTraces implements a command to return the traces of all
+// voucher classes.
+func GetCmdQueryClassTraces() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-traces",
+		Short: "Query the traces of all voucher classes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClassTraces(cmd.Context(), &types.QueryClassTracesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "class-traces")
+
+	return cmd
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/spf13/cobra"
+
+	"github.com/fatal-fruit/proton/x/nfttransfer/types"
+)
+
+const (
+	flagPacketTimeoutHeight    = "packet-timeout-height"
+	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagMemo                   = "memo"
+
+	// defaultRelativePacketTimeout is the timeout of packets without an
+	// explicit timeout, from the local time.
+	defaultRelativePacketTimeout = 10 * time.Minute
+)
+
+// GetTxCmd returns the transaction commands for the nfttransfer module.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "ICS-721 NFT transfer transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		GetCmdTransfer(),
+	)
+
+	return txCmd
+}
+
+// GetCmdTransfer implements a command to transfer NFTs over an ICS-721
+// channel.
+func GetCmdTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [src-port] [src-channel] [receiver] [class-id] [token-id,...]",
+		Short: "Transfer NFTs of a class to an account on a counterparty chain",
+		Long: `Transfer NFTs of a class to an account on a counterparty chain. Without
+timeout flags the packet times out ten minutes from the local time.`,
+		Example: "protond tx nfttransfer transfer nft-transfer channel-0 cosmos1... kitties kitty1,kitty2 --from mykey",
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			if timeoutHeight.IsZero() && timeoutTimestamp == 0 {
+				timeoutTimestamp = uint64(time.Now().Add(defaultRelativePacketTimeout).UnixNano())
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransfer(
+				args[0], args[1], args[3], strings.Split(args[4], ","),
+				clientCtx.GetFromAddress().String(), args[2], timeoutHeight, timeoutTimestamp, memo,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Packet timeout block height on the counterparty chain, as {revision}-{height}")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, 0, "Packet timeout timestamp on the counterparty chain, in nanoseconds since epoch")
+	cmd.Flags().String(flagMemo, "", "Memo of the packet")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
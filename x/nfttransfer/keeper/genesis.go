@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/nfttransfer/types"
+)
+
+// InitGenesis new nfttransfer genesis. The port is bound unless the
+// capability of the port was restored from the capability genesis.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	k.SetPort(ctx, data.PortId)
+
+	if !k.IsBound(ctx, data.PortId) {
+		if err := k.BindPort(ctx, data.PortId); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+
+	for _, trace := range data.Traces {
+		k.SetClassTrace(ctx, trace)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetPort(ctx), k.GetAllClassTraces(ctx))
+}
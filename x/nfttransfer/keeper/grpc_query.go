@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/fatal-fruit/proton/x/nfttransfer/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ClassTrace returns the trace of a voucher class.
+func (k Keeper) ClassTrace(c context.Context, req *types.QueryClassTraceRequest) (*types.QueryClassTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := types.ParseHexHash(req.Hash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	trace, found := k.GetClassTrace(ctx, hash)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrTraceNotFound, req.Hash).Error())
+	}
+
+	return &types.QueryClassTraceResponse{ClassTrace: trace}, nil
+}
+
+// ClassTraces returns the traces of all voucher classes.
+func (k Keeper) ClassTraces(c context.Context, req *types.QueryClassTracesRequest) (*types.QueryClassTracesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTracePrefix)
+
+	var traces []types.ClassTrace
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var trace types.ClassTrace
+		if err := k.cdc.Unmarshal(value, &trace); err != nil {
+			return err
+		}
+
+		traces = append(traces, trace)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClassTracesResponse{ClassTraces: traces, Pagination: pageRes}, nil
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/fatal-fruit/proton/x/nfttransfer/types"
+)
+
+// Keeper of the x/nfttransfer store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeKey     storetypes.StoreKey
+	ics4Wrapper  porttypes.ICS4Wrapper
+	portKeeper   types.PortKeeper
+	scopedKeeper capabilitykeeper.ScopedKeeper
+	nftKeeper    types.NFTKeeper
+}
+
+// NewKeeper creates a new x/nfttransfer Keeper instance. Packets are sent
+// through ics4Wrapper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	portKeeper types.PortKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
+	nftKeeper types.NFTKeeper,
+) Keeper {
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     key,
+		ics4Wrapper:  ics4Wrapper,
+		portKeeper:   portKeeper,
+		scopedKeeper: scopedKeeper,
+		nftKeeper:    nftKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetPort returns the port the module is bound to.
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	return string(ctx.KVStore(k.storeKey).Get(types.PortKey))
+}
+
+// SetPort stores the port the module is bound to.
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	ctx.KVStore(k.storeKey).Set(types.PortKey, []byte(portID))
+}
+
+// IsBound returns true if the module owns the capability of portID.
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort binds portID and claims its capability.
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// AuthenticateCapability wraps the scoped keeper's AuthenticateCapability.
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, capability, name)
+}
+
+// ClaimCapability claims the channel or port capability passed by the IBC
+// core module.
+func (k Keeper) ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, capability, name)
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/x/nfttransfer/types"
+)
+
+func setup(t *testing.T) (*app.ProtonApp, sdk.Context) {
+	t.Helper()
+
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	return protonApp, ctx
+}
+
+func TestParseClassTrace(t *testing.T) {
+	testCases := []struct {
+		fullClassPath string
+		expected      types.ClassTrace
+	}{
+		{"kitties", types.ClassTrace{BaseClassId: "kitties"}},
+		{"nft-transfer/channel-0/kitties", types.ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "kitties"}},
+		{"nft-transfer/channel-0/nft-transfer/channel-7/kitties", types.ClassTrace{Path: "nft-transfer/channel-0/nft-transfer/channel-7", BaseClassId: "kitties"}},
+		{"nft-transfer/channel-0/kitties/gen1", types.ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "kitties/gen1"}},
+		{"nft-transfer/channel-0", types.ClassTrace{BaseClassId: "nft-transfer/channel-0"}},
+		{"kitties/channel-0/gen1", types.ClassTrace{Path: "kitties/channel-0", BaseClassId: "gen1"}},
+	}
+
+	for _, tc := range testCases {
+		trace := types.ParseClassTrace(tc.fullClassPath)
+		require.Equal(t, tc.expected, trace, tc.fullClassPath)
+		require.Equal(t, tc.fullClassPath, trace.GetFullClassPath())
+		require.NoError(t, trace.Validate())
+	}
+
+	require.Equal(t, "kitties", types.ClassTrace{BaseClassId: "kitties"}.IBCClassID())
+	require.Regexp(t, "^ibc/[0-9A-F]{64}$", types.ParseClassTrace("nft-transfer/channel-0/kitties").IBCClassID())
+}
+
+func TestGenesisAndQueries(t *testing.T) {
+	protonApp, ctx := setup(t)
+	k := protonApp.NFTTransferKeeper
+
+	traces := []types.ClassTrace{
+		types.ParseClassTrace("nft-transfer/channel-0/kitties"),
+		types.ParseClassTrace("nft-transfer/channel-1/kitties"),
+		types.ParseClassTrace("nft-transfer/channel-1/nft-transfer/channel-4/punks"),
+	}
+	genesis := types.NewGenesisState(types.PortID, traces)
+	require.NoError(t, types.ValidateGenesis(*genesis))
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(types.PortID, append(traces, traces[0]))))
+
+	k.InitGenesis(ctx, genesis)
+	require.True(t, k.IsBound(ctx, types.PortID))
+	exported := k.ExportGenesis(ctx)
+	require.Equal(t, types.PortID, exported.PortId)
+	require.ElementsMatch(t, traces, exported.Traces)
+
+	res, err := k.ClassTrace(sdk.WrapSDKContext(ctx), &types.QueryClassTraceRequest{Hash: traces[2].IBCClassID()})
+	require.NoError(t, err)
+	require.Equal(t, traces[2], res.ClassTrace)
+
+	_, err = k.ClassTrace(sdk.WrapSDKContext(ctx), &types.QueryClassTraceRequest{Hash: types.ParseClassTrace("nft-transfer/channel-9/kitties").Hash().String()})
+	require.Error(t, err)
+	_, err = k.ClassTrace(sdk.WrapSDKContext(ctx), &types.QueryClassTraceRequest{Hash: "ibc/kitties"})
+	require.Error(t, err)
+
+	page, err := k.ClassTraces(sdk.WrapSDKContext(ctx), &types.QueryClassTracesRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, page.ClassTraces, 2)
+	require.Equal(t, uint64(3), page.Pagination.Total)
+}
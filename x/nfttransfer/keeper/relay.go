@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/fatal-fruit/proton/x/nfttransfer/types"
+)
+
+// SendTransfer sends tokenIDs of classID owned by sender to receiver over
+// sourceChannel and returns the packet sequence. The NFTs are escrowed, or
+// burned if they are vouchers returning over the channel they were received
+// on. Only the class and token URIs are sent along, the data of classes and
+// NFTs stays on this chain.
+func (k Keeper) SendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel,
+	classID string,
+	tokenIDs []string,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	class, found := k.nftKeeper.GetClass(ctx, classID)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrInvalidClassID, "class %s not found", classID)
+	}
+
+	trace, err := k.ClassTraceFromID(ctx, classID)
+	if err != nil {
+		return 0, err
+	}
+	fullClassPath := trace.GetFullClassPath()
+	isSink := strings.HasPrefix(fullClassPath, types.ClassPrefixOf(sourcePort, sourceChannel))
+	escrow := types.GetEscrowAddress(sourcePort, sourceChannel)
+
+	tokenURIs := make([]string, len(tokenIDs))
+	for i, tokenID := range tokenIDs {
+		token, found := k.nftKeeper.GetNFT(ctx, classID, tokenID)
+		if !found {
+			return 0, errorsmod.Wrapf(types.ErrInvalidTokenID, "nft %s of class %s not found", tokenID, classID)
+		}
+		if !sender.Equals(k.nftKeeper.GetOwner(ctx, classID, tokenID)) {
+			return 0, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, tokenID)
+		}
+		tokenURIs[i] = token.Uri
+
+		if isSink {
+			err = k.nftKeeper.Burn(ctx, classID, tokenID)
+		} else {
+			err = k.nftKeeper.Transfer(ctx, classID, tokenID, escrow)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	data := types.NewNonFungibleTokenPacketData(fullClassPath, class.Uri, tokenIDs, tokenURIs, sender.String(), receiver, memo)
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data.GetBytes())
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, fullClassPath),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(tokenIDs, ",")),
+		),
+	)
+
+	return sequence, nil
+}
+
+// OnRecvPacket releases the escrowed NFTs of data returning to this chain
+// to the receiver, or mints them as NFTs of a voucher class. The voucher
+// class is created on first receipt, with its trace as class data.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid receiver address")
+	}
+
+	// the class was sent from this chain, its NFTs are in escrow
+	voucherPrefix := types.ClassPrefixOf(packet.SourcePort, packet.SourceChannel)
+	if strings.HasPrefix(data.ClassId, voucherPrefix) {
+		classID := types.ParseClassTrace(strings.TrimPrefix(data.ClassId, voucherPrefix)).IBCClassID()
+		escrow := types.GetEscrowAddress(packet.DestinationPort, packet.DestinationChannel)
+		for _, tokenID := range data.TokenIds {
+			if !escrow.Equals(k.nftKeeper.GetOwner(ctx, classID, tokenID)) {
+				return errorsmod.Wrapf(types.ErrInvalidTokenID, "nft %s of class %s is not escrowed", tokenID, classID)
+			}
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, receiver); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	trace := types.ParseClassTrace(types.ClassPrefixOf(packet.DestinationPort, packet.DestinationChannel) + data.ClassId)
+	if err := trace.Validate(); err != nil {
+		return err
+	}
+
+	classID := trace.IBCClassID()
+	if !k.HasClassTrace(ctx, trace.Hash()) {
+		k.SetClassTrace(ctx, trace)
+	}
+	if !k.nftKeeper.HasClass(ctx, classID) {
+		traceData, err := codectypes.NewAnyWithValue(&trace)
+		if err != nil {
+			return err
+		}
+		if err := k.nftKeeper.SaveClass(ctx, nft.Class{Id: classID, Uri: data.ClassUri, Data: traceData}); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClass,
+				sdk.NewAttribute(types.AttributeKeyClassID, classID),
+				sdk.NewAttribute(types.AttributeKeyTrace, trace.GetFullClassPath()),
+			),
+		)
+	}
+
+	for i, tokenID := range data.TokenIds {
+		if err := k.nftKeeper.Mint(ctx, nft.NFT{ClassId: classID, Id: tokenID, Uri: data.GetTokenURI(i)}, receiver); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OnAcknowledgementPacket refunds the NFTs of a failed transfer.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	if ack.Success() {
+		return nil
+	}
+
+	return k.refundPacket(ctx, packet, data)
+}
+
+// OnTimeoutPacket refunds the NFTs of a timed out transfer.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundPacket(ctx, packet, data)
+}
+
+// refundPacket returns the NFTs of packet to their sender, releasing them
+// from escrow or minting the burned vouchers again.
+func (k Keeper) refundPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	classID := types.ParseClassTrace(data.ClassId).IBCClassID()
+	isSink := strings.HasPrefix(data.ClassId, types.ClassPrefixOf(packet.SourcePort, packet.SourceChannel))
+	for i, tokenID := range data.TokenIds {
+		if isSink {
+			err = k.nftKeeper.Mint(ctx, nft.NFT{ClassId: classID, Id: tokenID, Uri: data.GetTokenURI(i)}, sender)
+		} else {
+			err = k.nftKeeper.Transfer(ctx, classID, tokenID, sender)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/nfttransfer/types"
+)
+
+// SetClassTrace stores trace under its hash.
+func (k Keeper) SetClassTrace(ctx sdk.Context, trace types.ClassTrace) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTracePrefix)
+	store.Set(trace.Hash(), k.cdc.MustMarshal(&trace))
+}
+
+// GetClassTrace returns the class trace stored under hash.
+func (k Keeper) GetClassTrace(ctx sdk.Context, hash tmbytes.HexBytes) (types.ClassTrace, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTracePrefix)
+	bz := store.Get(hash)
+	if bz == nil {
+		return types.ClassTrace{}, false
+	}
+
+	var trace types.ClassTrace
+	k.cdc.MustUnmarshal(bz, &trace)
+	return trace, true
+}
+
+// HasClassTrace returns true if a class trace is stored under hash.
+func (k Keeper) HasClassTrace(ctx sdk.Context, hash tmbytes.HexBytes) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTracePrefix)
+	return store.Has(hash)
+}
+
+// GetAllClassTraces returns all stored class traces.
+func (k Keeper) GetAllClassTraces(ctx sdk.Context) []types.ClassTrace {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ClassTracePrefix)
+	defer iter.Close()
+
+	var traces []types.ClassTrace
+	for ; iter.Valid(); iter.Next() {
+		var trace types.ClassTrace
+		k.cdc.MustUnmarshal(iter.Value(), &trace)
+		traces = append(traces, trace)
+	}
+
+	return traces
+}
+
+// ClassTraceFromID returns the trace of the class classID on this chain.
+// Classes created on this chain have an empty path.
+func (k Keeper) ClassTraceFromID(ctx sdk.Context, classID string) (types.ClassTrace, error) {
+	if !strings.HasPrefix(classID, types.ClassPrefix+"/") {
+		return types.ClassTrace{BaseClassId: classID}, nil
+	}
+
+	hash, err := types.ParseHexHash(classID)
+	if err != nil {
+		return types.ClassTrace{}, err
+	}
+
+	trace, found := k.GetClassTrace(ctx, hash)
+	if !found {
+		return types.ClassTrace{}, errorsmod.Wrap(types.ErrTraceNotFound, classID)
+	}
+
+	return trace, nil
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/nfttransfer module sentinel errors
+var (
+	ErrInvalidClassID     = errorsmod.Register(ModuleName, 2, "invalid class ID")
+	ErrInvalidTokenID     = errorsmod.Register(ModuleName, 3, "invalid token ID")
+	ErrInvalidPacket      = errorsmod.Register(ModuleName, 4, "invalid non-fungible token packet")
+	ErrInvalidVersion     = errorsmod.Register(ModuleName, 5, "invalid ICS-721 version")
+	ErrTraceNotFound      = errorsmod.Register(ModuleName, 6, "class trace not found")
+	ErrInvalidTrace       = errorsmod.Register(ModuleName, 7, "invalid class trace")
+	ErrInvalidAcknowledge = errorsmod.Register(ModuleName, 8, "invalid acknowledgement")
+)
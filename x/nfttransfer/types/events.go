@@ -0,0 +1,17 @@
+package types
+
+// x/nfttransfer module event types
+const (
+	EventTypeTransfer = "nft_transfer"
+	EventTypePacket   = "non_fungible_token_packet"
+	EventTypeTimeout  = "nft_timeout"
+	EventTypeClass    = "voucher_class"
+
+	AttributeKeySender   = "sender"
+	AttributeKeyReceiver = "receiver"
+	AttributeKeyClassID  = "class_id"
+	AttributeKeyTokenIDs = "token_ids"
+	AttributeKeySuccess  = "success"
+	AttributeKeyError    = "error"
+	AttributeKeyTrace    = "trace"
+)
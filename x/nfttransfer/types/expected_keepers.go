@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// NFTKeeper defines the expected x/nft keeper
+type NFTKeeper interface {
+	SaveClass(ctx sdk.Context, class nft.Class) error
+	GetClass(ctx sdk.Context, classID string) (nft.Class, bool)
+	HasClass(ctx sdk.Context, classID string) bool
+	Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error
+	Burn(ctx sdk.Context, classID string, nftID string) error
+	Transfer(ctx sdk.Context, classID string, nftID string, receiver sdk.AccAddress) error
+	GetNFT(ctx sdk.Context, classID, nftID string) (nft.NFT, bool)
+	GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress
+}
+
+// PortKeeper defines the expected IBC port keeper
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
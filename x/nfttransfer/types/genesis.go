@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(portID string, traces []ClassTrace) *GenesisState {
+	return &GenesisState{
+		PortId: portID,
+		Traces: traces,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(PortID, []ClassTrace{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := host.PortIdentifierValidator(data.PortId); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.Traces))
+	for _, trace := range data.Traces {
+		if err := trace.Validate(); err != nil {
+			return err
+		}
+
+		hash := trace.Hash().String()
+		if seen[hash] {
+			return fmt.Errorf("duplicate class trace %s", trace.GetFullClassPath())
+		}
+		seen[hash] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "nfttransfer"
+
+	// StoreKey defines the primary module store key. It differs from the
+	// module name, which the x/nft store key is a prefix of.
+	StoreKey = "ics721"
+
+	// PortID is the default port the module binds to.
+	PortID = "nft-transfer"
+
+	// Version defines the current version of the ICS-721 protocol.
+	Version = "ics721-1"
+
+	// ClassPrefix is the prefix of the IDs of voucher classes.
+	ClassPrefix = "ibc"
+)
+
+var (
+	// ClassTracePrefix is the store prefix of the class traces, keyed by
+	// hash.
+	ClassTracePrefix = []byte{0x01}
+	// PortKey is the store key of the port bound by the module.
+	PortKey = []byte{0x02}
+)
+
+// GetEscrowAddress returns the address NFTs sent over portID and channelID
+// are escrowed in. It is derived like the ICS-20 escrow addresses, from the
+// ICS-721 version instead.
+func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, fmt.Sprintf("%s/%s", portID, channelID)...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
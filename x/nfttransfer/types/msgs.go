@@ -0,0 +1,81 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+var _ sdk.Msg = &MsgTransfer{}
+
+// NewMsgTransfer creates a new MsgTransfer instance
+func NewMsgTransfer(
+	sourcePort, sourceChannel, classID string, tokenIDs []string,
+	sender, receiver string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, memo string,
+) *MsgTransfer {
+	return &MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		ClassId:          classID,
+		TokenIds:         tokenIDs,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgTransfer message.
+func (m *MsgTransfer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(m.SourcePort); err != nil {
+		return errorsmod.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(m.SourceChannel); err != nil {
+		return errorsmod.Wrap(err, "invalid source channel ID")
+	}
+	if strings.TrimSpace(m.ClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassID, "class ID cannot be blank")
+	}
+	if len(m.TokenIds) == 0 {
+		return errorsmod.Wrap(ErrInvalidTokenID, "token IDs cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(m.TokenIds))
+	for _, tokenID := range m.TokenIds {
+		if strings.TrimSpace(tokenID) == "" {
+			return errorsmod.Wrap(ErrInvalidTokenID, "token ID cannot be blank")
+		}
+		if seen[tokenID] {
+			return errorsmod.Wrapf(ErrInvalidTokenID, "duplicate token ID %s", tokenID)
+		}
+		seen[tokenID] = true
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if strings.TrimSpace(m.Receiver) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "missing receiver address")
+	}
+	if m.TimeoutHeight.IsZero() && m.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "timeout height and timeout timestamp cannot both be zero")
+	}
+
+	return nil
+}
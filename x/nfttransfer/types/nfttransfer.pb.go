@@ -0,0 +1,984 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proton/nfttransfer/v1/nfttransfer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClassTrace is the path an NFT class took over IBC. A voucher class is
+// stored under the hash of its full path, which is also kept as the class
+// data of the voucher.
+type ClassTrace struct {
+	// path is the sequence of port and channel identifiers the class was
+	// received over, the latest hop first.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// base_class_id is the class ID on the chain it was created on.
+	BaseClassId string `protobuf:"bytes,2,opt,name=base_class_id,json=baseClassId,proto3" json:"base_class_id,omitempty"`
+}
+
+func (m *ClassTrace) Reset()         { *m = ClassTrace{} }
+func (m *ClassTrace) String() string { return proto.CompactTextString(m) }
+func (*ClassTrace) ProtoMessage()    {}
+func (*ClassTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f753c49f32e4b910, []int{0}
+}
+func (m *ClassTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassTrace.Merge(m, src)
+}
+func (m *ClassTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassTrace proto.InternalMessageInfo
+
+func (m *ClassTrace) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ClassTrace) GetBaseClassId() string {
+	if m != nil {
+		return m.BaseClassId
+	}
+	return ""
+}
+
+// NonFungibleTokenPacketData is the ICS-721 packet data of an NFT transfer.
+type NonFungibleTokenPacketData struct {
+	// class_id is the full class path, as known by the sending chain.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// class_uri is the optional URI of the class.
+	ClassUri string `protobuf:"bytes,2,opt,name=class_uri,json=classUri,proto3" json:"class_uri,omitempty"`
+	// class_data is the optional opaque data of the class.
+	ClassData string `protobuf:"bytes,3,opt,name=class_data,json=classData,proto3" json:"class_data,omitempty"`
+	// token_ids are the IDs of the transferred NFTs.
+	TokenIds []string `protobuf:"bytes,4,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
+	// token_uris are the URIs of the NFTs, in token_ids order.
+	TokenUris []string `protobuf:"bytes,5,rep,name=token_uris,json=tokenUris,proto3" json:"token_uris,omitempty"`
+	// token_data are the opaque data of the NFTs, in token_ids order.
+	TokenData []string `protobuf:"bytes,6,rep,name=token_data,json=tokenData,proto3" json:"token_data,omitempty"`
+	// sender is the sender address on the sending chain.
+	Sender string `protobuf:"bytes,7,opt,name=sender,proto3" json:"sender,omitempty"`
+	// receiver is the receiver address on the receiving chain.
+	Receiver string `protobuf:"bytes,8,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// memo is an optional note.
+	Memo string `protobuf:"bytes,9,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *NonFungibleTokenPacketData) Reset()         { *m = NonFungibleTokenPacketData{} }
+func (m *NonFungibleTokenPacketData) String() string { return proto.CompactTextString(m) }
+func (*NonFungibleTokenPacketData) ProtoMessage()    {}
+func (*NonFungibleTokenPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f753c49f32e4b910, []int{1}
+}
+func (m *NonFungibleTokenPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NonFungibleTokenPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NonFungibleTokenPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NonFungibleTokenPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonFungibleTokenPacketData.Merge(m, src)
+}
+func (m *NonFungibleTokenPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *NonFungibleTokenPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonFungibleTokenPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NonFungibleTokenPacketData proto.InternalMessageInfo
+
+func (m *NonFungibleTokenPacketData) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *NonFungibleTokenPacketData) GetClassUri() string {
+	if m != nil {
+		return m.ClassUri
+	}
+	return ""
+}
+
+func (m *NonFungibleTokenPacketData) GetClassData() string {
+	if m != nil {
+		return m.ClassData
+	}
+	return ""
+}
+
+func (m *NonFungibleTokenPacketData) GetTokenIds() []string {
+	if m != nil {
+		return m.TokenIds
+	}
+	return nil
+}
+
+func (m *NonFungibleTokenPacketData) GetTokenUris() []string {
+	if m != nil {
+		return m.TokenUris
+	}
+	return nil
+}
+
+func (m *NonFungibleTokenPacketData) GetTokenData() []string {
+	if m != nil {
+		return m.TokenData
+	}
+	return nil
+}
+
+func (m *NonFungibleTokenPacketData) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *NonFungibleTokenPacketData) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *NonFungibleTokenPacketData) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ClassTrace)(nil), "proton.nfttransfer.v1.ClassTrace")
+	proto.RegisterType((*NonFungibleTokenPacketData)(nil), "proton.nfttransfer.v1.NonFungibleTokenPacketData")
+}
+
+func init() {
+	proto.RegisterFile("proton/nfttransfer/v1/nfttransfer.proto", fileDescriptor_f753c49f32e4b910)
+}
+
+var fileDescriptor_f753c49f32e4b910 = []byte{
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x4e, 0x02, 0x31,
+	0x10, 0x86, 0x59, 0x40, 0x60, 0xc7, 0x78, 0x69, 0xa2, 0xa9, 0x10, 0x37, 0x84, 0x8b, 0x5e, 0x64,
+	0x43, 0x7c, 0x03, 0x25, 0x26, 0xc4, 0xc4, 0x18, 0x03, 0x17, 0x2f, 0xa4, 0xbb, 0x3b, 0x0b, 0x0d,
+	0xd0, 0x92, 0xb6, 0x4b, 0xf4, 0x2d, 0x3c, 0xf9, 0x4c, 0x1e, 0x39, 0x7a, 0x34, 0xf0, 0x22, 0xa6,
+	0x2d, 0x9a, 0xf5, 0xd6, 0x99, 0xef, 0xff, 0xff, 0x69, 0x66, 0xe0, 0x72, 0xad, 0xa4, 0x91, 0x22,
+	0x16, 0xb9, 0x31, 0x8a, 0x09, 0x9d, 0xa3, 0x8a, 0x37, 0x83, 0x72, 0xd9, 0x77, 0x0a, 0x72, 0xea,
+	0x85, 0xfd, 0x32, 0xd9, 0x0c, 0x7a, 0x43, 0x80, 0xbb, 0x25, 0xd3, 0x7a, 0xac, 0x58, 0x8a, 0x84,
+	0x40, 0x7d, 0xcd, 0xcc, 0x9c, 0x06, 0xdd, 0xe0, 0x2a, 0x7c, 0x76, 0x6f, 0xd2, 0x83, 0x93, 0x84,
+	0x69, 0x9c, 0xa6, 0x56, 0x36, 0xe5, 0x19, 0xad, 0x3a, 0x78, 0x6c, 0x9b, 0xce, 0x3a, 0xca, 0x7a,
+	0x1f, 0x55, 0x68, 0x3f, 0x4a, 0x71, 0x5f, 0x88, 0x19, 0x4f, 0x96, 0x38, 0x96, 0x0b, 0x14, 0x4f,
+	0x2c, 0x5d, 0xa0, 0x19, 0x32, 0xc3, 0xc8, 0x39, 0xb4, 0xfe, 0xdc, 0x3e, 0xba, 0x99, 0x7a, 0x27,
+	0xe9, 0x40, 0xe8, 0x51, 0xa1, 0xf8, 0x21, 0xd9, 0x6b, 0x27, 0x8a, 0x93, 0x0b, 0x00, 0x0f, 0x33,
+	0x66, 0x18, 0xad, 0x39, 0xea, 0xe5, 0x2e, 0xb6, 0x03, 0xa1, 0xb1, 0x93, 0xa6, 0x3c, 0xd3, 0xb4,
+	0xde, 0xad, 0x59, 0xaf, 0x6b, 0x8c, 0x32, 0x6d, 0xbd, 0x1e, 0x16, 0x8a, 0x6b, 0x7a, 0xe4, 0xa8,
+	0x97, 0x4f, 0x14, 0x2f, 0x61, 0x17, 0xdd, 0x28, 0x61, 0x17, 0x7d, 0x06, 0x0d, 0x8d, 0x22, 0x43,
+	0x45, 0x9b, 0x6e, 0xea, 0xa1, 0x22, 0x6d, 0x68, 0x29, 0x4c, 0x91, 0x6f, 0x50, 0xd1, 0x96, 0xff,
+	0xed, 0x6f, 0x6d, 0x97, 0xb7, 0xc2, 0x95, 0xa4, 0xa1, 0x5f, 0x9e, 0x7d, 0xdf, 0x3e, 0x7c, 0xee,
+	0xa2, 0x60, 0xbb, 0x8b, 0x82, 0xef, 0x5d, 0x14, 0xbc, 0xef, 0xa3, 0xca, 0x76, 0x1f, 0x55, 0xbe,
+	0xf6, 0x51, 0xe5, 0x65, 0x30, 0xe3, 0x66, 0x5e, 0x24, 0xfd, 0x54, 0xae, 0xe2, 0x9c, 0x19, 0xb6,
+	0xbc, 0xce, 0x55, 0xc1, 0x4d, 0x7c, 0xb8, 0xe7, 0xeb, 0xbf, 0x8b, 0x9a, 0xb7, 0x35, 0xea, 0xa4,
+	0xe1, 0xd8, 0xcd, 0xcf, 0x00, 0x9c, 0xb1, 0xb1, 0xbf, 0xf4, 0x01, 0x00, 0x00,
+}
+
+func (m *ClassTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseClassId) > 0 {
+		i -= len(m.BaseClassId)
+		copy(dAtA[i:], m.BaseClassId)
+		i = encodeVarintNfttransfer(dAtA, i, uint64(len(m.BaseClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintNfttransfer(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NonFungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NonFungibleTokenPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NonFungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintNfttransfer(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintNfttransfer(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintNfttransfer(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TokenData) > 0 {
+		for iNdEx := len(m.TokenData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenData[iNdEx])
+			copy(dAtA[i:], m.TokenData[iNdEx])
+			i = encodeVarintNfttransfer(dAtA, i, uint64(len(m.TokenData[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TokenUris) > 0 {
+		for iNdEx := len(m.TokenUris) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenUris[iNdEx])
+			copy(dAtA[i:], m.TokenUris[iNdEx])
+			i = encodeVarintNfttransfer(dAtA, i, uint64(len(m.TokenUris[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TokenIds) > 0 {
+		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenIds[iNdEx])
+			copy(dAtA[i:], m.TokenIds[iNdEx])
+			i = encodeVarintNfttransfer(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClassData) > 0 {
+		i -= len(m.ClassData)
+		copy(dAtA[i:], m.ClassData)
+		i = encodeVarintNfttransfer(dAtA, i, uint64(len(m.ClassData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassUri) > 0 {
+		i -= len(m.ClassUri)
+		copy(dAtA[i:], m.ClassUri)
+		i = encodeVarintNfttransfer(dAtA, i, uint64(len(m.ClassUri)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNfttransfer(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNfttransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNfttransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClassTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovNfttransfer(uint64(l))
+	}
+	l = len(m.BaseClassId)
+	if l > 0 {
+		n += 1 + l + sovNfttransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *NonFungibleTokenPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNfttransfer(uint64(l))
+	}
+	l = len(m.ClassUri)
+	if l > 0 {
+		n += 1 + l + sovNfttransfer(uint64(l))
+	}
+	l = len(m.ClassData)
+	if l > 0 {
+		n += 1 + l + sovNfttransfer(uint64(l))
+	}
+	if len(m.TokenIds) > 0 {
+		for _, s := range m.TokenIds {
+			l = len(s)
+			n += 1 + l + sovNfttransfer(uint64(l))
+		}
+	}
+	if len(m.TokenUris) > 0 {
+		for _, s := range m.TokenUris {
+			l = len(s)
+			n += 1 + l + sovNfttransfer(uint64(l))
+		}
+	}
+	if len(m.TokenData) > 0 {
+		for _, s := range m.TokenData {
+			l = len(s)
+			n += 1 + l + sovNfttransfer(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovNfttransfer(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovNfttransfer(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovNfttransfer(uint64(l))
+	}
+	return n
+}
+
+func sovNfttransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNfttransfer(x uint64) (n int) {
+	return sovNfttransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClassTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNfttransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNfttransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNfttransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNfttransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NonFungibleTokenPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNfttransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NonFungibleTokenPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NonFungibleTokenPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNfttransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNfttransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNfttransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNfttransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenUris", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNfttransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenUris = append(m.TokenUris, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNfttransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenData = append(m.TokenData, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNfttransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNfttransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNfttransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNfttransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNfttransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNfttransfer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNfttransfer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNfttransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNfttransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNfttransfer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNfttransfer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNfttransfer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNfttransfer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNfttransfer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNfttransfer = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/jsonpb"
+)
+
+// NewNonFungibleTokenPacketData creates a new NonFungibleTokenPacketData
+// object.
+func NewNonFungibleTokenPacketData(classID, classURI string, tokenIDs, tokenURIs []string, sender, receiver, memo string) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassId:   classID,
+		ClassUri:  classURI,
+		TokenIds:  tokenIDs,
+		TokenUris: tokenURIs,
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      memo,
+	}
+}
+
+// ValidateBasic performs a basic check of the packet data.
+func (data NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(data.ClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassID, "class ID cannot be blank")
+	}
+	if len(data.TokenIds) == 0 {
+		return errorsmod.Wrap(ErrInvalidTokenID, "token IDs cannot be empty")
+	}
+	if len(data.TokenUris) != 0 && len(data.TokenUris) != len(data.TokenIds) {
+		return errorsmod.Wrap(ErrInvalidPacket, "token URIs must match the token IDs")
+	}
+	if len(data.TokenData) != 0 && len(data.TokenData) != len(data.TokenIds) {
+		return errorsmod.Wrap(ErrInvalidPacket, "token data must match the token IDs")
+	}
+	if strings.TrimSpace(data.Sender) == "" {
+		return errorsmod.Wrap(ErrInvalidPacket, "sender cannot be blank")
+	}
+	if strings.TrimSpace(data.Receiver) == "" {
+		return errorsmod.Wrap(ErrInvalidPacket, "receiver cannot be blank")
+	}
+
+	return nil
+}
+
+// GetTokenURI returns the URI of the i-th token, if any.
+func (data NonFungibleTokenPacketData) GetTokenURI(i int) string {
+	if i < len(data.TokenUris) {
+		return data.TokenUris[i]
+	}
+
+	return ""
+}
+
+// GetBytes returns the sorted JSON encoding of the packet data, with the
+// camel case field names of the ICS-721 specification.
+func (data NonFungibleTokenPacketData) GetBytes() []byte {
+	bz, err := (&jsonpb.Marshaler{}).MarshalToString(&data)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON([]byte(bz))
+}
+
+// DecodePacketData decodes the ICS-721 packet data of bz.
+func DecodePacketData(bz []byte) (NonFungibleTokenPacketData, error) {
+	var data NonFungibleTokenPacketData
+	if err := jsonpb.Unmarshal(bytes.NewReader(bz), &data); err != nil {
+		return data, errorsmod.Wrapf(ErrInvalidPacket, "cannot unmarshal ICS-721 packet data: %s", err)
+	}
+
+	return data, nil
+}
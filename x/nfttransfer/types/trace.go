@@ -0,0 +1,102 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// ParseClassTrace parses the full path of a class, "{port}/{channel}/..."
+// hops followed by the base class ID, into its trace. Leading segments are
+// taken as hops as long as they are port and channel identifier pairs.
+func ParseClassTrace(fullClassPath string) ClassTrace {
+	segments := strings.Split(fullClassPath, "/")
+
+	i := 0
+	for ; i+2 < len(segments); i += 2 {
+		if host.PortIdentifierValidator(segments[i]) != nil || !channeltypes.IsValidChannelID(segments[i+1]) {
+			break
+		}
+	}
+
+	return ClassTrace{
+		Path:        strings.Join(segments[:i], "/"),
+		BaseClassId: strings.Join(segments[i:], "/"),
+	}
+}
+
+// GetFullClassPath returns the full path of the class.
+func (ct ClassTrace) GetFullClassPath() string {
+	if ct.Path == "" {
+		return ct.BaseClassId
+	}
+
+	return ct.Path + "/" + ct.BaseClassId
+}
+
+// Hash returns the hash of the full path of the class.
+func (ct ClassTrace) Hash() tmbytes.HexBytes {
+	hash := sha256.Sum256([]byte(ct.GetFullClassPath()))
+	return hash[:]
+}
+
+// IBCClassID returns the ID of the class on this chain, "ibc/{hash}" for
+// vouchers and the base class ID otherwise.
+func (ct ClassTrace) IBCClassID() string {
+	if ct.Path == "" {
+		return ct.BaseClassId
+	}
+
+	return fmt.Sprintf("%s/%s", ClassPrefix, ct.Hash())
+}
+
+// Validate performs a basic validation of the class trace.
+func (ct ClassTrace) Validate() error {
+	if strings.TrimSpace(ct.BaseClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidTrace, "base class ID cannot be blank")
+	}
+	if ct.Path == "" {
+		return nil
+	}
+
+	segments := strings.Split(ct.Path, "/")
+	if len(segments)%2 != 0 {
+		return errorsmod.Wrapf(ErrInvalidTrace, "path %s must be port and channel identifier pairs", ct.Path)
+	}
+	for i := 0; i < len(segments); i += 2 {
+		if err := host.PortIdentifierValidator(segments[i]); err != nil {
+			return errorsmod.Wrapf(ErrInvalidTrace, "invalid port %s: %s", segments[i], err)
+		}
+		if !channeltypes.IsValidChannelID(segments[i+1]) {
+			return errorsmod.Wrapf(ErrInvalidTrace, "invalid channel %s", segments[i+1])
+		}
+	}
+
+	return nil
+}
+
+// ParseHexHash parses the hash of a class trace, with or without the "ibc/"
+// prefix of voucher class IDs.
+func ParseHexHash(hash string) (tmbytes.HexBytes, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(hash, ClassPrefix+"/"))
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidTrace, "invalid hash %s: %s", hash, err)
+	}
+	if len(bz) != sha256.Size {
+		return nil, errorsmod.Wrapf(ErrInvalidTrace, "invalid hash %s: expected %d bytes", hash, sha256.Size)
+	}
+
+	return bz, nil
+}
+
+// ClassPrefixOf returns the "{port}/{channel}/" prefix classes sent over
+// portID and channelID get on the receiving chain.
+func ClassPrefixOf(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/", portID, channelID)
+}
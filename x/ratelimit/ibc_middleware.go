@@ -0,0 +1,94 @@
+package ratelimit
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/fatal-fruit/proton/internal/ibcdenom"
+	"github.com/fatal-fruit/proton/x/ratelimit/keeper"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware rate limits the ICS-20 packets received by the wrapped
+// transfer app and reverts the outflow of refunded sends. Sends are limited by
+// the keeper acting as ICS4Wrapper of the transfer keeper. Every other
+// callback goes to the wrapped app unchanged.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping app.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. Packets over quota are
+// acknowledged with an error, so the counterparty refunds the sender.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	if err := im.keeper.RecvFlow(ctx, packet.DestinationChannel, ibcdenom.RecvDenom(packet, data), amount); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The outflow of
+// a send acknowledged with an error is reverted, its tokens are refunded.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil && !ack.Success() {
+		im.keeper.RefundSend(ctx, packet.SourceChannel, packet.Sequence)
+	} else {
+		im.keeper.DeletePendingSend(ctx, packet.SourceChannel, packet.Sequence)
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The outflow of a timed
+// out send is reverted, its tokens are refunded.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.RefundSend(ctx, packet.SourceChannel, packet.Sequence)
+	return nil
+}
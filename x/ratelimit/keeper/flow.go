@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/ratelimit/types"
+)
+
+// SendFlow adds amount of denom sent over channelID to the outflow of its
+// quota. It fails if the net outflow of the window would exceed the quota. It
+// returns the updated flow and whether denom is rate limited over channelID.
+func (k Keeper) SendFlow(ctx sdk.Context, channelID, denom string, amount sdkmath.Int) (types.Flow, bool, error) {
+	quota, ok := k.GetParams(ctx).GetQuota(channelID, denom)
+	if !ok {
+		return types.Flow{}, false, nil
+	}
+
+	flow := k.CurrentFlow(ctx, quota)
+	flow.Outflow = flow.Outflow.Add(amount)
+	if err := checkQuota(flow.Outflow.Sub(flow.Inflow), flow.ChannelValue, quota.MaxPercentSend); err != nil {
+		return types.Flow{}, false, errorsmod.Wrapf(err, "sending %s%s over %s", amount, denom, channelID)
+	}
+
+	k.SetFlow(ctx, flow)
+	return flow, true, nil
+}
+
+// RecvFlow adds amount of denom received over channelID to the inflow of its
+// quota. It fails if the net inflow of the window would exceed the quota.
+func (k Keeper) RecvFlow(ctx sdk.Context, channelID, denom string, amount sdkmath.Int) error {
+	quota, ok := k.GetParams(ctx).GetQuota(channelID, denom)
+	if !ok {
+		return nil
+	}
+
+	flow := k.CurrentFlow(ctx, quota)
+	flow.Inflow = flow.Inflow.Add(amount)
+	if err := checkQuota(flow.Inflow.Sub(flow.Outflow), flow.ChannelValue, quota.MaxPercentRecv); err != nil {
+		return errorsmod.Wrapf(err, "receiving %s%s over %s", amount, denom, channelID)
+	}
+
+	k.SetFlow(ctx, flow)
+	return nil
+}
+
+// RefundSend reverts the outflow of a pending send whose tokens are refunded
+// to the sender. Sends of a previous window are only forgotten.
+func (k Keeper) RefundSend(ctx sdk.Context, channelID string, sequence uint64) {
+	send, found := k.GetPendingSend(ctx, channelID, sequence)
+	if !found {
+		return
+	}
+	k.DeletePendingSend(ctx, channelID, sequence)
+
+	flow, found := k.GetFlow(ctx, channelID, send.Denom)
+	if !found || !flow.WindowStart.Equal(send.WindowStart) {
+		return
+	}
+
+	flow.Outflow = sdk.MaxInt(flow.Outflow.Sub(send.Amount), sdk.ZeroInt())
+	k.SetFlow(ctx, flow)
+}
+
+// checkQuota fails if netFlow exceeds maxPercent of channelValue.
+func checkQuota(netFlow, channelValue sdkmath.Int, maxPercent sdk.Dec) error {
+	threshold := sdk.NewDecFromInt(channelValue).Mul(maxPercent).TruncateInt()
+	if netFlow.GT(threshold) {
+		return errorsmod.Wrapf(types.ErrQuotaExceeded, "net flow %s exceeds %s of %s", netFlow, maxPercent, channelValue)
+	}
+
+	return nil
+}
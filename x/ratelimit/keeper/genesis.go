@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/fatal-fruit/proton/x/ratelimit/types"
+)
+
+// InitGenesis new ratelimit genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, flow := range data.Flows {
+		k.SetFlow(ctx, flow)
+	}
+
+	for _, send := range data.PendingSends {
+		k.SetPendingSend(ctx, send)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllFlows(ctx), k.GetAllPendingSends(ctx))
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/fatal-fruit/proton/x/ratelimit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the ratelimit module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Flow returns the flow of the current window of a quota.
+func (k Keeper) Flow(c context.Context, req *types.QueryFlowRequest) (*types.QueryFlowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	quota, ok := k.GetParams(ctx).GetQuota(req.ChannelId, req.Denom)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrQuotaNotFound, "%s on %s", req.Denom, req.ChannelId)
+	}
+
+	return &types.QueryFlowResponse{Flow: k.CurrentFlow(ctx, quota), Quota: quota}, nil
+}
+
+// Flows returns the flows of the current windows of all quotas.
+func (k Keeper) Flows(c context.Context, _ *types.QueryFlowsRequest) (*types.QueryFlowsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	quotas := k.GetParams(ctx).Quotas
+
+	flows := make([]types.Flow, len(quotas))
+	for i, quota := range quotas {
+		flows[i] = k.CurrentFlow(ctx, quota)
+	}
+
+	return &types.QueryFlowsResponse{Flows: flows}, nil
+}
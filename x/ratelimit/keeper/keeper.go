@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+
+	"github.com/fatal-fruit/proton/x/ratelimit/types"
+)
+
+// Keeper of the x/ratelimit store
+type Keeper struct {
+	cdc         codec.BinaryCodec
+	storeKey    storetypes.StoreKey
+	bankKeeper  types.BankKeeper
+	ics4Wrapper porttypes.ICS4Wrapper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new x/ratelimit Keeper instance. Packets are sent
+// through ics4Wrapper once their outflow is within quota.
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, bk types.BankKeeper, ics4Wrapper porttypes.ICS4Wrapper, authority string) Keeper {
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    key,
+		bankKeeper:  bk,
+		ics4Wrapper: ics4Wrapper,
+		authority:   authority,
+	}
+}
+
+// GetAuthority returns the x/ratelimit module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/ratelimit module parameters. The flows of removed
+// quotas are dropped.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	for _, flow := range k.GetAllFlows(ctx) {
+		if _, ok := params.GetQuota(flow.ChannelId, flow.Denom); !ok {
+			store.Delete(types.FlowKey(flow.ChannelId, flow.Denom))
+		}
+	}
+
+	return nil
+}
+
+// GetParams returns the current x/ratelimit module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetFlow stores flow.
+func (k Keeper) SetFlow(ctx sdk.Context, flow types.Flow) {
+	ctx.KVStore(k.storeKey).Set(types.FlowKey(flow.ChannelId, flow.Denom), k.cdc.MustMarshal(&flow))
+}
+
+// GetFlow returns the stored flow of denom over channelID.
+func (k Keeper) GetFlow(ctx sdk.Context, channelID, denom string) (types.Flow, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FlowKey(channelID, denom))
+	if bz == nil {
+		return types.Flow{}, false
+	}
+
+	var flow types.Flow
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow, true
+}
+
+// GetAllFlows returns all stored flows.
+func (k Keeper) GetAllFlows(ctx sdk.Context) []types.Flow {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FlowPrefix)
+	defer iter.Close()
+
+	var flows []types.Flow
+	for ; iter.Valid(); iter.Next() {
+		var flow types.Flow
+		k.cdc.MustUnmarshal(iter.Value(), &flow)
+		flows = append(flows, flow)
+	}
+
+	return flows
+}
+
+// CurrentFlow returns the flow of the current window of quota. A new window
+// starts at the current block time, valued at the current supply of the
+// denom, once the previous one ended.
+func (k Keeper) CurrentFlow(ctx sdk.Context, quota types.Quota) types.Flow {
+	flow, found := k.GetFlow(ctx, quota.ChannelId, quota.Denom)
+	if found && ctx.BlockTime().Before(flow.WindowStart.Add(quota.Duration)) {
+		return flow
+	}
+
+	return types.Flow{
+		Denom:        quota.Denom,
+		ChannelId:    quota.ChannelId,
+		Inflow:       sdk.ZeroInt(),
+		Outflow:      sdk.ZeroInt(),
+		ChannelValue: k.bankKeeper.GetSupply(ctx, quota.Denom).Amount,
+		WindowStart:  ctx.BlockTime(),
+	}
+}
+
+// SetPendingSend stores send.
+func (k Keeper) SetPendingSend(ctx sdk.Context, send types.PendingSend) {
+	ctx.KVStore(k.storeKey).Set(types.PendingSendKey(send.ChannelId, send.Sequence), k.cdc.MustMarshal(&send))
+}
+
+// GetPendingSend returns the pending send of sequence on channelID.
+func (k Keeper) GetPendingSend(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSend, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PendingSendKey(channelID, sequence))
+	if bz == nil {
+		return types.PendingSend{}, false
+	}
+
+	var send types.PendingSend
+	k.cdc.MustUnmarshal(bz, &send)
+	return send, true
+}
+
+// DeletePendingSend removes the pending send of sequence on channelID.
+func (k Keeper) DeletePendingSend(ctx sdk.Context, channelID string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.PendingSendKey(channelID, sequence))
+}
+
+// GetAllPendingSends returns all pending sends.
+func (k Keeper) GetAllPendingSends(ctx sdk.Context) []types.PendingSend {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PendingSendPrefix)
+	defer iter.Close()
+
+	var sends []types.PendingSend
+	for ; iter.Valid(); iter.Next() {
+		var send types.PendingSend
+		k.cdc.MustUnmarshal(iter.Value(), &send)
+		sends = append(sends, send)
+	}
+
+	return sends
+}
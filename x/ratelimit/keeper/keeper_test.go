@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/x/ratelimit/types"
+)
+
+// denom is the rate limited denom, of which the genesis holds no supply.
+const denom = "uatom"
+
+func setup(t *testing.T) (*app.ProtonApp, sdk.Context) {
+	t.Helper()
+
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Unix(1_000_000, 0)})
+
+	// 1000uatom of supply
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	require.NoError(t, protonApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+
+	return protonApp, ctx
+}
+
+func TestFlowWindows(t *testing.T) {
+	protonApp, ctx := setup(t)
+	k := protonApp.RateLimitKeeper
+
+	quota := types.NewQuota(denom, "channel-0", sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), time.Hour)
+	require.NoError(t, k.SetParams(ctx, types.NewParams(quota)))
+
+	// denoms and channels without quota are not limited
+	_, limited, err := k.SendFlow(ctx, "channel-1", denom, sdk.NewInt(1000))
+	require.NoError(t, err)
+	require.False(t, limited)
+
+	// the net outflow may reach a tenth of the supply
+	flow, limited, err := k.SendFlow(ctx, "channel-0", denom, sdk.NewInt(100))
+	require.NoError(t, err)
+	require.True(t, limited)
+	require.Equal(t, sdk.NewInt(1000), flow.ChannelValue)
+	_, _, err = k.SendFlow(ctx, "channel-0", denom, sdk.NewInt(1))
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+
+	// inflow offsets outflow
+	require.NoError(t, k.RecvFlow(ctx, "channel-0", denom, sdk.NewInt(300)))
+	require.ErrorIs(t, k.RecvFlow(ctx, "channel-0", denom, sdk.NewInt(1)), types.ErrQuotaExceeded)
+	_, _, err = k.SendFlow(ctx, "channel-0", denom, sdk.NewInt(300))
+	require.NoError(t, err)
+
+	// refunds only revert the outflow of the window they were sent in
+	k.SetPendingSend(ctx, types.PendingSend{ChannelId: "channel-0", Sequence: 1, Denom: denom, Amount: sdk.NewInt(300), WindowStart: flow.WindowStart})
+	k.SetPendingSend(ctx, types.PendingSend{ChannelId: "channel-0", Sequence: 2, Denom: denom, Amount: sdk.NewInt(100), WindowStart: flow.WindowStart.Add(-time.Hour)})
+	k.RefundSend(ctx, "channel-0", 1)
+	k.RefundSend(ctx, "channel-0", 2)
+	flow, found := k.GetFlow(ctx, "channel-0", denom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(100), flow.Outflow)
+	require.Empty(t, k.GetAllPendingSends(ctx))
+
+	// a new window starts once the quota duration passed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	flow = k.CurrentFlow(ctx, quota)
+	require.True(t, flow.Outflow.IsZero())
+	require.Equal(t, ctx.BlockTime(), flow.WindowStart)
+}
+
+func TestGenesisAndParams(t *testing.T) {
+	protonApp, ctx := setup(t)
+	k := protonApp.RateLimitKeeper
+
+	quota := types.NewQuota(denom, "channel-0", sdk.OneDec(), sdk.OneDec(), time.Hour)
+	genesis := types.NewGenesisState(
+		types.NewParams(quota),
+		[]types.Flow{k.CurrentFlow(ctx, quota)},
+		[]types.PendingSend{{ChannelId: "channel-0", Sequence: 1, Denom: denom, Amount: sdk.NewInt(1), WindowStart: ctx.BlockTime()}},
+	)
+	require.NoError(t, types.ValidateGenesis(*genesis))
+
+	k.InitGenesis(ctx, genesis)
+	exported := k.ExportGenesis(ctx)
+	require.Equal(t, genesis.Params, exported.Params)
+	require.Len(t, exported.Flows, 1)
+	require.Len(t, exported.PendingSends, 1)
+
+	// quotas must be unique and their flows go with them
+	require.Error(t, k.SetParams(ctx, types.NewParams(quota, quota)))
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	require.Empty(t, k.GetAllFlows(ctx))
+
+	invalid := []types.Quota{
+		types.NewQuota("", "channel-0", sdk.OneDec(), sdk.OneDec(), time.Hour),
+		types.NewQuota(denom, "not a channel", sdk.OneDec(), sdk.OneDec(), time.Hour),
+		types.NewQuota(denom, "channel-0", sdk.NewDec(2), sdk.OneDec(), time.Hour),
+		types.NewQuota(denom, "channel-0", sdk.OneDec(), sdk.OneDec(), 0),
+	}
+	for _, quota := range invalid {
+		require.Error(t, types.NewParams(quota).Validate())
+	}
+}
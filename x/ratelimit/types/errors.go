@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/ratelimit module sentinel errors
+var (
+	ErrQuotaExceeded = errorsmod.Register(ModuleName, 2, "rate limit quota exceeded")
+	ErrQuotaNotFound = errorsmod.Register(ModuleName, 3, "rate limit quota not found")
+)
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected bank keeper used to value the channels.
+type BankKeeper interface {
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+}
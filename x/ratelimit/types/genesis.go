@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, flows []Flow, pendingSends []PendingSend) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		Flows:        flows,
+		PendingSends: pendingSends,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Flow{}, []PendingSend{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, flow := range data.Flows {
+		if _, ok := data.Params.GetQuota(flow.ChannelId, flow.Denom); !ok {
+			return fmt.Errorf("flow of %s on %s has no quota", flow.Denom, flow.ChannelId)
+		}
+		if flow.Inflow.IsNil() || flow.Inflow.IsNegative() || flow.Outflow.IsNil() || flow.Outflow.IsNegative() {
+			return fmt.Errorf("flow of %s on %s must be non-negative", flow.Denom, flow.ChannelId)
+		}
+		if flow.ChannelValue.IsNil() || flow.ChannelValue.IsNegative() {
+			return fmt.Errorf("channel value of %s on %s must be non-negative", flow.Denom, flow.ChannelId)
+		}
+	}
+
+	for _, send := range data.PendingSends {
+		if send.Amount.IsNil() || !send.Amount.IsPositive() {
+			return fmt.Errorf("pending send %d on %s must have a positive amount", send.Sequence, send.ChannelId)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "ratelimit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the store key of the x/ratelimit parameters.
+	ParamsKey = []byte{0x01}
+	// FlowPrefix is the store prefix of the flows, keyed by channel and denom.
+	FlowPrefix = []byte{0x02}
+	// PendingSendPrefix is the store prefix of the pending sends, keyed by
+	// channel and sequence.
+	PendingSendPrefix = []byte{0x03}
+)
+
+// FlowKey returns the store key of the flow of denom over channelID.
+func FlowKey(channelID, denom string) []byte {
+	return append(append(append(FlowPrefix, []byte(channelID)...), '/'), []byte(denom)...)
+}
+
+// PendingSendKey returns the store key of the pending send of sequence on
+// channelID.
+func PendingSendKey(channelID string, sequence uint64) []byte {
+	return append(append(append(PendingSendPrefix, []byte(channelID)...), '/'), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewQuota creates a new Quota instance.
+func NewQuota(denom, channelID string, maxPercentSend, maxPercentRecv sdk.Dec, duration time.Duration) Quota {
+	return Quota{
+		Denom:          denom,
+		ChannelId:      channelID,
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		Duration:       duration,
+	}
+}
+
+// Validate performs a stateless validation of the quota.
+func (q Quota) Validate() error {
+	if err := sdk.ValidateDenom(q.Denom); err != nil {
+		return fmt.Errorf("invalid quota denom: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(q.ChannelId); err != nil {
+		return fmt.Errorf("invalid quota channel: %w", err)
+	}
+	for name, percent := range map[string]sdk.Dec{"send": q.MaxPercentSend, "recv": q.MaxPercentRecv} {
+		if percent.IsNil() || percent.IsNegative() || percent.GT(sdk.OneDec()) {
+			return fmt.Errorf("max percent %s must be within [0, 1]: %s", name, percent)
+		}
+	}
+	if q.Duration <= 0 {
+		return fmt.Errorf("quota duration must be positive: %s", q.Duration)
+	}
+
+	return nil
+}
+
+// NewParams creates a new Params instance.
+func NewParams(quotas ...Quota) Params {
+	return Params{
+		Quotas: quotas,
+	}
+}
+
+// DefaultParams returns the default x/ratelimit parameters, limiting no
+// transfer.
+func DefaultParams() Params {
+	return NewParams()
+}
+
+// Validate performs a stateless validation of the parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Quotas))
+	for _, quota := range p.Quotas {
+		if err := quota.Validate(); err != nil {
+			return err
+		}
+
+		key := string(FlowKey(quota.ChannelId, quota.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicate quota for %s on %s", quota.Denom, quota.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// GetQuota returns the quota of denom over channelID.
+func (p Params) GetQuota(channelID, denom string) (Quota, bool) {
+	for _, quota := range p.Quotas {
+		if quota.ChannelId == channelID && quota.Denom == denom {
+			return quota, true
+		}
+	}
+
+	return Quota{}, false
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/x/refund/keeper"
+	"github.com/fatal-fruit/proton/x/refund/types"
+)
+
+func setup(t *testing.T) (*app.ProtonApp, sdk.Context) {
+	t.Helper()
+
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	return protonApp, ctx
+}
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.Params
+		expErr bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{"half refunded", types.NewParams(sdk.NewDecWithPrec(5, 1)), false},
+		{"all refunded", types.NewParams(sdk.OneDec()), false},
+		{"nil refund share", types.Params{}, true},
+		{"negative refund share", types.NewParams(sdk.NewDec(-1)), true},
+		{"refund share above one", types.NewParams(sdk.NewDecWithPrec(11, 1)), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisAndQueries(t *testing.T) {
+	protonApp, ctx := setup(t)
+	k := protonApp.RefundKeeper
+
+	genesis := types.NewGenesisState(types.NewParams(sdk.NewDecWithPrec(5, 1)))
+	require.NoError(t, types.ValidateGenesis(*genesis))
+
+	k.InitGenesis(ctx, genesis)
+	require.Equal(t, genesis, k.ExportGenesis(ctx))
+
+	res, err := k.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, genesis.Params, res.Params)
+
+	require.Error(t, k.SetParams(ctx, types.NewParams(sdk.NewDec(2))))
+	require.Equal(t, genesis.Params, k.GetParams(ctx))
+}
+
+func TestUpdateParams(t *testing.T) {
+	protonApp, ctx := setup(t)
+	k := protonApp.RefundKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.NewParams(sdk.NewDecWithPrec(25, 2))
+
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: sdk.AccAddress("not_gov_____________").String(),
+		Params:    params,
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    types.NewParams(sdk.NewDec(2)),
+	})
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params instance.
+func NewParams(refundShare sdk.Dec) Params {
+	return Params{
+		RefundShare: refundShare,
+	}
+}
+
+// DefaultParams returns the default x/refund parameters. Refunds are disabled
+// until governance sets a refund share.
+func DefaultParams() Params {
+	return NewParams(sdk.ZeroDec())
+}
+
+// Validate performs a stateless validation of the parameters.
+func (p Params) Validate() error {
+	if p.RefundShare.IsNil() {
+		return fmt.Errorf("refund share cannot be nil")
+	}
+	if p.RefundShare.IsNegative() || p.RefundShare.GT(sdk.OneDec()) {
+		return fmt.Errorf("refund share must be between 0 and 1: %s", p.RefundShare)
+	}
+
+	return nil
+}
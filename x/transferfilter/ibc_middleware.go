@@ -0,0 +1,54 @@
+package transferfilter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/fatal-fruit/proton/internal/ibcdenom"
+	"github.com/fatal-fruit/proton/x/transferfilter/keeper"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware filters the ICS-20 packets received by the wrapped transfer
+// app. Sends are filtered by the keeper acting as ICS4Wrapper of the transfer
+// keeper. Every other callback goes to the wrapped app unchanged.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping app.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. Packets of denoms not
+// allowed over the channel are acknowledged with an error, so the
+// counterparty refunds the sender. Core IBC discards the events of a packet
+// acknowledged with an error, so the denial is only logged.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	denom := ibcdenom.RecvDenom(packet, data)
+	if err := im.keeper.GetParams(ctx).CheckReceive(packet.DestinationChannel, denom); err != nil {
+		im.keeper.Logger(ctx).Info("transfer denied", "channel_id", packet.DestinationChannel, "denom", denom, "reason", err)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
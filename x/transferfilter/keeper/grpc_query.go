@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/fatal-fruit/proton/x/transferfilter/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the transferfilter module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ChannelFilter returns the filter of a channel.
+func (k Keeper) ChannelFilter(c context.Context, req *types.QueryChannelFilterRequest) (*types.QueryChannelFilterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	filter, found := k.GetParams(ctx).GetChannelFilter(req.ChannelId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrFilterNotFound, req.ChannelId)
+	}
+
+	return &types.QueryChannelFilterResponse{Filter: filter}, nil
+}
+
+// TransferAllowed returns whether a denom may be sent and received over a
+// channel.
+func (k Keeper) TransferAllowed(c context.Context, req *types.QueryTransferAllowedRequest) (*types.QueryTransferAllowedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	res := &types.QueryTransferAllowedResponse{SendAllowed: true, ReceiveAllowed: true}
+	if err := params.CheckSend(req.ChannelId, req.Denom); err != nil {
+		res.SendAllowed = false
+		res.Reason = err.Error()
+	}
+	if err := params.CheckReceive(req.ChannelId, req.Denom); err != nil {
+		res.ReceiveAllowed = false
+		if res.Reason == "" {
+			res.Reason = err.Error()
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+
+	"github.com/fatal-fruit/proton/x/transferfilter/types"
+)
+
+// Keeper of the x/transferfilter store
+type Keeper struct {
+	cdc         codec.BinaryCodec
+	storeKey    storetypes.StoreKey
+	ics4Wrapper porttypes.ICS4Wrapper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new x/transferfilter Keeper instance. Packets are sent
+// through ics4Wrapper once allowed by the filters.
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, ics4Wrapper porttypes.ICS4Wrapper, authority string) Keeper {
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    key,
+		ics4Wrapper: ics4Wrapper,
+		authority:   authority,
+	}
+}
+
+// GetAuthority returns the x/transferfilter module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/transferfilter module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/transferfilter module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
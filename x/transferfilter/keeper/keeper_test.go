@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fatal-fruit/proton/app"
+	"github.com/fatal-fruit/proton/x/transferfilter/types"
+)
+
+func setup(t *testing.T) (*app.ProtonApp, sdk.Context) {
+	t.Helper()
+
+	protonApp := app.Setup(t)
+	ctx := protonApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	return protonApp, ctx
+}
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.Params
+		expErr bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{"valid", types.NewParams(true, []types.ChannelFilter{
+			types.NewChannelFilter("channel-0", true, false, []string{"stake"}, nil),
+			types.NewChannelFilter("channel-1", false, true, nil, []string{"stake"}),
+		}, []string{"uatom"}), false},
+		{"invalid channel", types.NewParams(false, []types.ChannelFilter{
+			types.NewChannelFilter("chan", true, true, nil, nil),
+		}, nil), true},
+		{"duplicate channel", types.NewParams(false, []types.ChannelFilter{
+			types.NewChannelFilter("channel-0", true, true, nil, nil),
+			types.NewChannelFilter("channel-0", false, false, nil, nil),
+		}, nil), true},
+		{"allowed and blocked", types.NewParams(false, []types.ChannelFilter{
+			types.NewChannelFilter("channel-0", true, true, []string{"stake"}, []string{"stake"}),
+		}, nil), true},
+		{"invalid denom", types.NewParams(false, nil, []string{"1"}), true},
+		{"duplicate denom", types.NewParams(false, nil, []string{"stake", "stake"}), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckTransfer(t *testing.T) {
+	params := types.NewParams(false, []types.ChannelFilter{
+		types.NewChannelFilter("channel-0", true, false, []string{"stake", "uatom"}, nil),
+		types.NewChannelFilter("channel-1", true, true, nil, []string{"uatom"}),
+	}, []string{"uosmo"})
+
+	require.NoError(t, params.CheckSend("channel-0", "stake"))
+	require.ErrorIs(t, params.CheckReceive("channel-0", "stake"), types.ErrChannelNotAllowed)
+	require.ErrorIs(t, params.CheckSend("channel-0", "ujuno"), types.ErrDenomNotAllowed)
+	require.NoError(t, params.CheckReceive("channel-1", "ujuno"))
+	require.ErrorIs(t, params.CheckSend("channel-1", "uatom"), types.ErrDenomNotAllowed)
+	require.ErrorIs(t, params.CheckSend("channel-2", "uosmo"), types.ErrDenomNotAllowed)
+	require.NoError(t, params.CheckSend("channel-2", "uatom"))
+
+	params.RestrictChannels = true
+	require.ErrorIs(t, params.CheckReceive("channel-2", "uatom"), types.ErrChannelNotAllowed)
+	require.ErrorIs(t, params.CheckReceive("channel-1", "uatom"), types.ErrDenomNotAllowed)
+}
+
+func TestGenesisAndQueries(t *testing.T) {
+	protonApp, ctx := setup(t)
+	k := protonApp.TransferFilterKeeper
+
+	genesis := types.NewGenesisState(types.NewParams(false, []types.ChannelFilter{
+		types.NewChannelFilter("channel-0", true, false, []string{"stake"}, nil),
+	}, []string{"uosmo"}))
+	require.NoError(t, types.ValidateGenesis(*genesis))
+
+	k.InitGenesis(ctx, genesis)
+	require.Equal(t, genesis, k.ExportGenesis(ctx))
+
+	filter, err := k.ChannelFilter(sdk.WrapSDKContext(ctx), &types.QueryChannelFilterRequest{ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, genesis.Params.Channels[0], filter.Filter)
+
+	_, err = k.ChannelFilter(sdk.WrapSDKContext(ctx), &types.QueryChannelFilterRequest{ChannelId: "channel-1"})
+	require.ErrorIs(t, err, types.ErrFilterNotFound)
+
+	allowed, err := k.TransferAllowed(sdk.WrapSDKContext(ctx), &types.QueryTransferAllowedRequest{ChannelId: "channel-0", Denom: "stake"})
+	require.NoError(t, err)
+	require.True(t, allowed.SendAllowed)
+	require.False(t, allowed.ReceiveAllowed)
+	require.NotEmpty(t, allowed.Reason)
+
+	allowed, err = k.TransferAllowed(sdk.WrapSDKContext(ctx), &types.QueryTransferAllowedRequest{ChannelId: "channel-1", Denom: "stake"})
+	require.NoError(t, err)
+	require.True(t, allowed.SendAllowed)
+	require.True(t, allowed.ReceiveAllowed)
+	require.Empty(t, allowed.Reason)
+
+	require.Error(t, k.SetParams(ctx, types.NewParams(false, nil, []string{"1"})))
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/transferfilter module sentinel errors
+var (
+	ErrChannelNotAllowed = errorsmod.Register(ModuleName, 2, "transfers not allowed over channel")
+	ErrDenomNotAllowed   = errorsmod.Register(ModuleName, 3, "denom not allowed over channel")
+	ErrFilterNotFound    = errorsmod.Register(ModuleName, 4, "channel filter not found")
+)
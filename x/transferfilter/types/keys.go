@@ -0,0 +1,13 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "transferfilter"
+
+	// StoreKey defines the primary module store key. It differs from the
+	// module name, which the "transfer" store key is a prefix of.
+	StoreKey = "ics20filter"
+)
+
+// ParamsKey is the store key of the x/transferfilter parameters.
+var ParamsKey = []byte{0x01}
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewChannelFilter creates a new ChannelFilter instance.
+func NewChannelFilter(channelID string, sendEnabled, receiveEnabled bool, allowedDenoms, blockedDenoms []string) ChannelFilter {
+	return ChannelFilter{
+		ChannelId:      channelID,
+		SendEnabled:    sendEnabled,
+		ReceiveEnabled: receiveEnabled,
+		AllowedDenoms:  allowedDenoms,
+		BlockedDenoms:  blockedDenoms,
+	}
+}
+
+// Validate performs a stateless validation of the filter.
+func (f ChannelFilter) Validate() error {
+	if err := host.ChannelIdentifierValidator(f.ChannelId); err != nil {
+		return fmt.Errorf("invalid filter channel: %w", err)
+	}
+	if err := validateDenoms(f.AllowedDenoms); err != nil {
+		return fmt.Errorf("invalid allowed denoms of %s: %w", f.ChannelId, err)
+	}
+	if err := validateDenoms(f.BlockedDenoms); err != nil {
+		return fmt.Errorf("invalid blocked denoms of %s: %w", f.ChannelId, err)
+	}
+	for _, denom := range f.BlockedDenoms {
+		if contains(f.AllowedDenoms, denom) {
+			return fmt.Errorf("denom %s both allowed and blocked on %s", denom, f.ChannelId)
+		}
+	}
+
+	return nil
+}
+
+// CheckDenom returns an error if denom may not be transferred over the
+// channel, whatever the direction.
+func (f ChannelFilter) CheckDenom(denom string) error {
+	if contains(f.BlockedDenoms, denom) {
+		return errorsmod.Wrapf(ErrDenomNotAllowed, "%s is blocked on %s", denom, f.ChannelId)
+	}
+	if len(f.AllowedDenoms) > 0 && !contains(f.AllowedDenoms, denom) {
+		return errorsmod.Wrapf(ErrDenomNotAllowed, "%s is not allowed on %s", denom, f.ChannelId)
+	}
+
+	return nil
+}
+
+// NewParams creates a new Params instance.
+func NewParams(restrictChannels bool, channels []ChannelFilter, blockedDenoms []string) Params {
+	return Params{
+		RestrictChannels: restrictChannels,
+		Channels:         channels,
+		BlockedDenoms:    blockedDenoms,
+	}
+}
+
+// DefaultParams returns the default x/transferfilter parameters, filtering no
+// transfer.
+func DefaultParams() Params {
+	return NewParams(false, nil, nil)
+}
+
+// Validate performs a stateless validation of the parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Channels))
+	for _, filter := range p.Channels {
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+		if seen[filter.ChannelId] {
+			return fmt.Errorf("duplicate filter for %s", filter.ChannelId)
+		}
+		seen[filter.ChannelId] = true
+	}
+
+	if err := validateDenoms(p.BlockedDenoms); err != nil {
+		return fmt.Errorf("invalid blocked denoms: %w", err)
+	}
+
+	return nil
+}
+
+// GetChannelFilter returns the filter of channelID.
+func (p Params) GetChannelFilter(channelID string) (ChannelFilter, bool) {
+	for _, filter := range p.Channels {
+		if filter.ChannelId == channelID {
+			return filter, true
+		}
+	}
+
+	return ChannelFilter{}, false
+}
+
+// CheckSend returns an error if denom may not be sent over channelID.
+func (p Params) CheckSend(channelID, denom string) error {
+	return p.checkTransfer(channelID, denom, func(f ChannelFilter) bool { return f.SendEnabled }, "sending")
+}
+
+// CheckReceive returns an error if denom may not be received over channelID.
+func (p Params) CheckReceive(channelID, denom string) error {
+	return p.checkTransfer(channelID, denom, func(f ChannelFilter) bool { return f.ReceiveEnabled }, "receiving")
+}
+
+func (p Params) checkTransfer(channelID, denom string, enabled func(ChannelFilter) bool, direction string) error {
+	if contains(p.BlockedDenoms, denom) {
+		return errorsmod.Wrapf(ErrDenomNotAllowed, "%s is blocked on all channels", denom)
+	}
+
+	filter, found := p.GetChannelFilter(channelID)
+	if !found {
+		if p.RestrictChannels {
+			return errorsmod.Wrapf(ErrChannelNotAllowed, "%s has no filter", channelID)
+		}
+		return nil
+	}
+
+	if !enabled(filter) {
+		return errorsmod.Wrapf(ErrChannelNotAllowed, "%s is disabled on %s", direction, channelID)
+	}
+
+	return filter.CheckDenom(denom)
+}
+
+func validateDenoms(denoms []string) error {
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func contains(denoms []string, denom string) bool {
+	for _, d := range denoms {
+		if d == denom {
+			return true
+		}
+	}
+
+	return false
+}
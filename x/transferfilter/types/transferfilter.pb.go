@@ -0,0 +1,815 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proton/transferfilter/v1/transferfilter.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChannelFilter restricts the ICS-20 transfers over a channel, on top of the
+// global send and receive switches of the transfer module.
+type ChannelFilter struct {
+	// channel_id is the transfer channel on this chain.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// send_enabled lets tokens be sent over the channel.
+	SendEnabled bool `protobuf:"varint,2,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// receive_enabled lets tokens be received over the channel.
+	ReceiveEnabled bool `protobuf:"varint,3,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+	// allowed_denoms are the only denoms transferred over the channel, any
+	// denom if empty. Denoms are the denoms on this chain, the IBC denoms of
+	// vouchers.
+	AllowedDenoms []string `protobuf:"bytes,4,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// blocked_denoms are the denoms never transferred over the channel.
+	BlockedDenoms []string `protobuf:"bytes,5,rep,name=blocked_denoms,json=blockedDenoms,proto3" json:"blocked_denoms,omitempty"`
+}
+
+func (m *ChannelFilter) Reset()         { *m = ChannelFilter{} }
+func (m *ChannelFilter) String() string { return proto.CompactTextString(m) }
+func (*ChannelFilter) ProtoMessage()    {}
+func (*ChannelFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_219833798944bfce, []int{0}
+}
+func (m *ChannelFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFilter.Merge(m, src)
+}
+func (m *ChannelFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFilter proto.InternalMessageInfo
+
+func (m *ChannelFilter) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelFilter) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *ChannelFilter) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
+func (m *ChannelFilter) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *ChannelFilter) GetBlockedDenoms() []string {
+	if m != nil {
+		return m.BlockedDenoms
+	}
+	return nil
+}
+
+// Params defines the set of x/transferfilter parameters.
+type Params struct {
+	// restrict_channels only lets transfers through the channels with a
+	// filter. Channels without a filter are unrestricted otherwise.
+	RestrictChannels bool `protobuf:"varint,1,opt,name=restrict_channels,json=restrictChannels,proto3" json:"restrict_channels,omitempty"`
+	// channels are the filters of the channels.
+	Channels []ChannelFilter `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels"`
+	// blocked_denoms are the denoms never transferred over any channel.
+	BlockedDenoms []string `protobuf:"bytes,3,rep,name=blocked_denoms,json=blockedDenoms,proto3" json:"blocked_denoms,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_219833798944bfce, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRestrictChannels() bool {
+	if m != nil {
+		return m.RestrictChannels
+	}
+	return false
+}
+
+func (m *Params) GetChannels() []ChannelFilter {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *Params) GetBlockedDenoms() []string {
+	if m != nil {
+		return m.BlockedDenoms
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ChannelFilter)(nil), "proton.transferfilter.v1.ChannelFilter")
+	proto.RegisterType((*Params)(nil), "proton.transferfilter.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("proton/transferfilter/v1/transferfilter.proto", fileDescriptor_219833798944bfce)
+}
+
+var fileDescriptor_219833798944bfce = []byte{
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x0e, 0xd2, 0x30,
+	0x1c, 0x80, 0x57, 0xa6, 0x84, 0x15, 0x41, 0x59, 0x3c, 0x2c, 0x24, 0xce, 0x89, 0x21, 0x2c, 0x18,
+	0xb6, 0xa0, 0x9e, 0x3c, 0xe2, 0x9f, 0xc4, 0x0b, 0x31, 0x3b, 0x7a, 0x59, 0xba, 0xad, 0x83, 0xc5,
+	0xad, 0x25, 0x6d, 0x41, 0x7d, 0x05, 0x4f, 0x3e, 0x86, 0x47, 0x1e, 0x83, 0x23, 0xde, 0x3c, 0x19,
+	0x03, 0x07, 0x5e, 0xc0, 0x07, 0x30, 0x6b, 0x07, 0x06, 0x02, 0x97, 0xa5, 0xf9, 0x7e, 0x5f, 0xbb,
+	0x7c, 0x2d, 0x1c, 0x2d, 0x18, 0x15, 0x94, 0xf8, 0x82, 0x21, 0xc2, 0x53, 0xcc, 0xd2, 0x2c, 0x17,
+	0x98, 0xf9, 0xab, 0xf1, 0x05, 0xf1, 0xa4, 0x67, 0x5a, 0x4a, 0xf7, 0x2e, 0x86, 0xab, 0x71, 0xb7,
+	0x83, 0x8a, 0x8c, 0x50, 0x5f, 0x7e, 0x95, 0xdc, 0x7d, 0x38, 0xa3, 0x33, 0x2a, 0x97, 0x7e, 0xb9,
+	0x52, 0xb4, 0xf7, 0x17, 0xc0, 0xd6, 0xeb, 0x39, 0x22, 0x04, 0xe7, 0xef, 0xe4, 0x6e, 0xf3, 0x11,
+	0x84, 0xb1, 0x02, 0x61, 0x96, 0x58, 0xc0, 0x01, 0xae, 0x11, 0x18, 0x15, 0x79, 0x9f, 0x98, 0x4f,
+	0xe0, 0x3d, 0x8e, 0x49, 0x12, 0x62, 0x82, 0xa2, 0x1c, 0x27, 0x56, 0xcd, 0x01, 0x6e, 0x23, 0x68,
+	0x96, 0xec, 0xad, 0x42, 0xe6, 0x00, 0xde, 0x67, 0x38, 0xc6, 0xd9, 0x0a, 0x9f, 0x2c, 0x5d, 0x5a,
+	0xed, 0x0a, 0x1f, 0xc5, 0x3e, 0x6c, 0xa3, 0x3c, 0xa7, 0x9f, 0x71, 0x12, 0x26, 0x98, 0xd0, 0x82,
+	0x5b, 0x77, 0x1c, 0xdd, 0x35, 0x82, 0x56, 0x45, 0xdf, 0x48, 0x58, 0x6a, 0x51, 0x4e, 0xe3, 0x4f,
+	0xff, 0xb5, 0xbb, 0x4a, 0xab, 0xa8, 0xd2, 0x5e, 0x0d, 0xbf, 0x1d, 0xd6, 0xc3, 0x7e, 0x75, 0x83,
+	0x5f, 0x2e, 0xef, 0xf0, 0x2c, 0xb2, 0xf7, 0x13, 0xc0, 0xfa, 0x07, 0xc4, 0x50, 0xc1, 0xcd, 0x67,
+	0xb0, 0xc3, 0x30, 0x17, 0x2c, 0x8b, 0x45, 0x58, 0x65, 0x72, 0x99, 0xdd, 0x08, 0x1e, 0x1c, 0x07,
+	0xd5, 0x66, 0x6e, 0x4e, 0x61, 0xe3, 0xe4, 0xd4, 0x1c, 0xdd, 0x6d, 0x3e, 0x1f, 0x78, 0xb7, 0x1e,
+	0xc1, 0x3b, 0xfb, 0xe5, 0xc4, 0xd8, 0xfc, 0x7e, 0xac, 0xfd, 0x38, 0xac, 0x87, 0x20, 0x38, 0x9d,
+	0x71, 0x25, 0x4d, 0xbf, 0x96, 0xf6, 0xb4, 0x4c, 0xb3, 0x6f, 0xa5, 0xa9, 0x90, 0xc9, 0x74, 0xb3,
+	0xb3, 0xc1, 0x76, 0x67, 0x83, 0x3f, 0x3b, 0x1b, 0x7c, 0xdf, 0xdb, 0xda, 0x76, 0x6f, 0x6b, 0xbf,
+	0xf6, 0xb6, 0xf6, 0xf1, 0xe5, 0x2c, 0x13, 0xf3, 0x65, 0xe4, 0xc5, 0xb4, 0xf0, 0x53, 0x24, 0x50,
+	0x3e, 0x4a, 0xd9, 0x32, 0x13, 0xfe, 0xad, 0x03, 0xc5, 0xd7, 0x05, 0xe6, 0x51, 0x5d, 0x8e, 0x5f,
+	0xfc, 0x1b, 0x00, 0xc8, 0x0e, 0x89, 0xca, 0x95, 0x02, 0x00, 0x00,
+}
+
+func (m *ChannelFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockedDenoms) > 0 {
+		for iNdEx := len(m.BlockedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedDenoms[iNdEx])
+			copy(dAtA[i:], m.BlockedDenoms[iNdEx])
+			i = encodeVarintTransferfilter(dAtA, i, uint64(len(m.BlockedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintTransferfilter(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransferfilter(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockedDenoms) > 0 {
+		for iNdEx := len(m.BlockedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedDenoms[iNdEx])
+			copy(dAtA[i:], m.BlockedDenoms[iNdEx])
+			i = encodeVarintTransferfilter(dAtA, i, uint64(len(m.BlockedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransferfilter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RestrictChannels {
+		i--
+		if m.RestrictChannels {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransferfilter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransferfilter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChannelFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransferfilter(uint64(l))
+	}
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovTransferfilter(uint64(l))
+		}
+	}
+	if len(m.BlockedDenoms) > 0 {
+		for _, s := range m.BlockedDenoms {
+			l = len(s)
+			n += 1 + l + sovTransferfilter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RestrictChannels {
+		n += 2
+	}
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovTransferfilter(uint64(l))
+		}
+	}
+	if len(m.BlockedDenoms) > 0 {
+		for _, s := range m.BlockedDenoms {
+			l = len(s)
+			n += 1 + l + sovTransferfilter(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTransferfilter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransferfilter(x uint64) (n int) {
+	return sovTransferfilter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChannelFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransferfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedDenoms = append(m.BlockedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransferfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransferfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransferfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictChannels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictChannels = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransferfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, ChannelFilter{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedDenoms = append(m.BlockedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransferfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransferfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransferfilter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransferfilter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransferfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransferfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransferfilter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransferfilter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransferfilter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransferfilter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransferfilter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransferfilter = fmt.Errorf("proto: unexpected end of group")
+)